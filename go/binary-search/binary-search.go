@@ -2,14 +2,15 @@ package binary_search
 
 import (
 	"cmp"
-	"errors"
+	common_errors "common-errors"
 	"math"
 )
 
 type OrderedSlice[T cmp.Ordered] []T
 
 /**
- * Returns first index of found needle within order array, or error if needle was not found
+ * Returns first index of found needle within order array, or `*common_errors.Not_Found_Error`
+ * if needle was not found
  */
 func (array OrderedSlice[T]) Binary_Search(needle T) (int, error) {
 	lo := 0
@@ -28,5 +29,5 @@ func (array OrderedSlice[T]) Binary_Search(needle T) (int, error) {
 		}
 	}
 
-	return int(-1), errors.New("Did not find needle in array")
+	return int(-1), &common_errors.Not_Found_Error{Target: "Needle", Container: "array"}
 }
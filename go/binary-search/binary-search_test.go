@@ -1,6 +1,8 @@
 package binary_search
 
 import (
+	common_errors "common-errors"
+	"errors"
 	"testing"
 )
 
@@ -55,8 +57,8 @@ func Test_Binary_Search_returns_error_when_needle_not_found(t *testing.T) {
 	needle := float32(limit + 2)
 
 	found_index, err := haystack.Binary_Search(needle)
-	if err == nil {
-		t.Fatalf(`Expected error not nil -> %v`, err)
+	if !errors.Is(err, common_errors.ErrNotFound) {
+		t.Fatalf(`Expected ErrNotFound but got -> %v`, err)
 	}
 
 	if found_index != expected_index {
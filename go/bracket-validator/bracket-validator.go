@@ -0,0 +1,222 @@
+package bracket_validator
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"stack"
+)
+
+type Mismatch_Kind uint8
+
+const (
+	// Closer found while nothing was open
+	Mismatch_Unexpected_Closer Mismatch_Kind = iota
+
+	// Closer found that does not match innermost opener
+	Mismatch_Wrong_Closer
+
+	// Input ended while openers were still open
+	Mismatch_Unclosed
+)
+
+type Repair_Action uint8
+
+const (
+	Repair_Insert Repair_Action = iota
+	Repair_Delete
+	Repair_Replace
+)
+
+// Single edit that would get validation past the reported mismatch
+type Repair struct {
+	Action   Repair_Action
+	Position Position
+
+	// Text to insert, or replacement text, empty for `Repair_Delete`
+	Text string
+}
+
+func (repair Repair) String() string {
+	switch repair.Action {
+	case Repair_Insert:
+		return fmt.Sprintf("insert %q at %s", repair.Text, repair.Position)
+	case Repair_Delete:
+		return fmt.Sprintf("delete at %s", repair.Position)
+	}
+	return fmt.Sprintf("replace with %q at %s", repair.Text, repair.Position)
+}
+
+// First structural problem found, `Repairs` lists alternatives with the
+// smallest edit first
+type Mismatch_Error struct {
+	Kind     Mismatch_Kind
+	Position Position
+
+	// Closer that was found, empty for `Mismatch_Unclosed`
+	Found string
+
+	// Closer that was expected, empty for `Mismatch_Unexpected_Closer`
+	Expected  string
+	Opened_At Position
+
+	Repairs []Repair
+}
+
+func (err *Mismatch_Error) Error() string {
+	switch err.Kind {
+	case Mismatch_Unexpected_Closer:
+		return fmt.Sprintf("%s: unexpected %q with nothing open", err.Position, err.Found)
+	case Mismatch_Wrong_Closer:
+		return fmt.Sprintf("%s: expected %q to close opener at %s but found %q", err.Position, err.Expected, err.Opened_At, err.Found)
+	}
+	return fmt.Sprintf("%s: expected %q to close opener at %s before end of input", err.Position, err.Expected, err.Opened_At)
+}
+
+/**
+ * Check every opener in reader has a matching closer in one streaming pass,
+ * returns nil when balanced, `*Mismatch_Error` for the first problem, or the
+ * reader's own error
+ *
+ * Nil or empty delimiters fall back to `Default_Delimiters`
+ *
+ * ## Example
+ *
+ *	err := Validate(file, append(Default_Delimiters, Delimiter{Open: "{{", Close: "}}"}))
+ *	var mismatch *Mismatch_Error
+ *	if errors.As(err, &mismatch) {
+ *		fmt.Println(mismatch, mismatch.Repairs)
+ *	}
+ */
+func Validate(reader io.Reader, delimiters []Delimiter) error {
+	tokenizer, err := New_Tokenizer(reader, delimiters)
+	if err != nil {
+		return err
+	}
+
+	open := stack.Stack[Token]{}
+
+	for {
+		token, err := tokenizer.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		is_symmetric := token.Delimiter.Open == token.Delimiter.Close
+		if is_symmetric {
+			if top, err := open.Peek(); err == nil && top.Delimiter == token.Delimiter {
+				open.Pop()
+				continue
+			}
+		}
+
+		if token.Opening {
+			open.Push(token)
+			continue
+		}
+
+		top, err := open.Peek()
+		if err != nil {
+			return &Mismatch_Error{
+				Kind:     Mismatch_Unexpected_Closer,
+				Position: token.Position,
+				Found:    token.Text,
+				Repairs: []Repair{
+					{Action: Repair_Delete, Position: token.Position},
+				},
+			}
+		}
+
+		if top.Delimiter != token.Delimiter {
+			return wrongCloser(&open, token)
+		}
+
+		open.Pop()
+	}
+
+	if open.Length > 0 {
+		top, _ := open.Peek()
+		position := tokenizer.Position()
+
+		return &Mismatch_Error{
+			Kind:      Mismatch_Unclosed,
+			Position:  position,
+			Expected:  top.Delimiter.Close,
+			Opened_At: top.Position,
+			Repairs: []Repair{
+				{Action: Repair_Insert, Position: position, Text: closersFor(&open, open.Length)},
+			},
+		}
+	}
+
+	return nil
+}
+
+// Suggest replacing or deleting the closer, each a single edit, then when it
+// matches an outer opener, inserting closers for every opener nested inside
+// that one, which takes one edit per opener
+func wrongCloser(open *stack.Stack[Token], token Token) *Mismatch_Error {
+	top, _ := open.Peek()
+
+	mismatch := &Mismatch_Error{
+		Kind:      Mismatch_Wrong_Closer,
+		Position:  token.Position,
+		Found:     token.Text,
+		Expected:  top.Delimiter.Close,
+		Opened_At: top.Position,
+		Repairs: []Repair{
+			{Action: Repair_Replace, Position: token.Position, Text: top.Delimiter.Close},
+			{Action: Repair_Delete, Position: token.Position},
+		},
+	}
+
+	// Openers that would need closing before `token` is valid, if any
+	depth := uint(0)
+	found := false
+	walk := stack.Stack[Token]{}
+	for open.Length > 0 {
+		opener, _ := open.Pop()
+		walk.Push(opener)
+		if opener.Delimiter == token.Delimiter {
+			found = true
+			break
+		}
+		depth++
+	}
+
+	// Restore stack so callers still see it intact
+	for walk.Length > 0 {
+		opener, _ := walk.Pop()
+		open.Push(opener)
+	}
+
+	if found {
+		mismatch.Repairs = append(mismatch.Repairs, Repair{
+			Action:   Repair_Insert,
+			Position: token.Position,
+			Text:     closersFor(open, depth),
+		})
+	}
+
+	return mismatch
+}
+
+// Closers for the innermost `count` openers, innermost first
+func closersFor(open *stack.Stack[Token], count uint) string {
+	text := ""
+	walk := stack.Stack[Token]{}
+	for i := uint(0); i < count && open.Length > 0; i++ {
+		opener, _ := open.Pop()
+		walk.Push(opener)
+		text += opener.Delimiter.Close
+	}
+
+	for walk.Length > 0 {
+		opener, _ := walk.Pop()
+		open.Push(opener)
+	}
+
+	return text
+}
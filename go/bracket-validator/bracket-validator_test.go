@@ -0,0 +1,202 @@
+package bracket_validator
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func mismatchOf(t *testing.T, input string, delimiters []Delimiter) *Mismatch_Error {
+	err := Validate(strings.NewReader(input), delimiters)
+
+	var mismatch *Mismatch_Error
+	if !errors.As(err, &mismatch) {
+		t.Fatalf(`Input %q expected *Mismatch_Error but got %v`, input, err)
+	}
+	return mismatch
+}
+
+func Test_Validate_accepts_balanced_input(t *testing.T) {
+	cases := []string{
+		"",
+		"no delimiters at all",
+		"([]{}<>)",
+		"func main() {\n\tfmt.Println([]int{1, 2})\n}\n",
+		"((((((((()))))))))",
+	}
+
+	for _, input := range cases {
+		if err := Validate(strings.NewReader(input), nil); err != nil {
+			t.Fatalf(`Input %q unexpected error %v`, input, err)
+		}
+	}
+}
+
+func Test_Validate_prefers_longest_delimiter(t *testing.T) {
+	delimiters := append([]Delimiter{{Open: "{{", Close: "}}"}}, Default_Delimiters...)
+
+	if err := Validate(strings.NewReader("{{ .Name }} { {{ if (x) }} }"), delimiters); err != nil {
+		t.Fatalf(`Unexpected error %v`, err)
+	}
+
+	mismatch := mismatchOf(t, "{{ x }", delimiters)
+	if mismatch.Kind != Mismatch_Wrong_Closer || mismatch.Expected != "}}" || mismatch.Found != "}" {
+		t.Fatalf(`Expected "}}" but found "}", got %v`, mismatch)
+	}
+}
+
+func Test_Validate_reports_wrong_closer_with_position(t *testing.T) {
+	mismatch := mismatchOf(t, "a(\n  [x\n    )", nil)
+
+	if mismatch.Kind != Mismatch_Wrong_Closer {
+		t.Fatalf(`Expected Mismatch_Wrong_Closer but got %v`, mismatch.Kind)
+	}
+	if mismatch.Position != (Position{Line: 3, Column: 5}) {
+		t.Fatalf(`Expected line 3, column 5 but got %v`, mismatch.Position)
+	}
+	if mismatch.Opened_At != (Position{Line: 2, Column: 3}) {
+		t.Fatalf(`Expected opener at line 2, column 3 but got %v`, mismatch.Opened_At)
+	}
+
+	expected := []Repair{
+		{Action: Repair_Replace, Position: Position{Line: 3, Column: 5}, Text: "]"},
+		{Action: Repair_Delete, Position: Position{Line: 3, Column: 5}},
+		{Action: Repair_Insert, Position: Position{Line: 3, Column: 5}, Text: "]"},
+	}
+	if len(mismatch.Repairs) != len(expected) {
+		t.Fatalf(`Expected %v repairs but got %v`, expected, mismatch.Repairs)
+	}
+	for i, repair := range expected {
+		if mismatch.Repairs[i] != repair {
+			t.Fatalf(`Expected repair %v but got %v`, repair, mismatch.Repairs[i])
+		}
+	}
+
+	message := `line 3, column 5: expected "]" to close opener at line 2, column 3 but found ")"`
+	if mismatch.Error() != message {
+		t.Fatalf(`Expected message %q but got %q`, message, mismatch.Error())
+	}
+}
+
+func Test_Validate_lists_wrong_closer_repairs_smallest_edit_first(t *testing.T) {
+	mismatch := mismatchOf(t, "([{)", nil)
+
+	expected := []Repair{
+		{Action: Repair_Replace, Position: Position{Line: 1, Column: 4}, Text: "}"},
+		{Action: Repair_Delete, Position: Position{Line: 1, Column: 4}},
+		{Action: Repair_Insert, Position: Position{Line: 1, Column: 4}, Text: "}]"},
+	}
+	if len(mismatch.Repairs) != len(expected) {
+		t.Fatalf(`Expected %v repairs but got %v`, expected, mismatch.Repairs)
+	}
+	for i, repair := range expected {
+		if mismatch.Repairs[i] != repair {
+			t.Fatalf(`Expected repair %v but got %v`, repair, mismatch.Repairs[i])
+		}
+	}
+}
+
+func Test_Validate_skips_insert_repair_when_closer_matches_nothing(t *testing.T) {
+	mismatch := mismatchOf(t, "(]", nil)
+
+	if len(mismatch.Repairs) != 2 || mismatch.Repairs[0].Action != Repair_Replace || mismatch.Repairs[1].Action != Repair_Delete {
+		t.Fatalf(`Expected replace and delete repairs but got %v`, mismatch.Repairs)
+	}
+}
+
+func Test_Validate_reports_unexpected_closer(t *testing.T) {
+	mismatch := mismatchOf(t, "()\n)", nil)
+
+	if mismatch.Kind != Mismatch_Unexpected_Closer {
+		t.Fatalf(`Expected Mismatch_Unexpected_Closer but got %v`, mismatch.Kind)
+	}
+	if mismatch.Position != (Position{Line: 2, Column: 1}) {
+		t.Fatalf(`Expected line 2, column 1 but got %v`, mismatch.Position)
+	}
+	if len(mismatch.Repairs) != 1 || mismatch.Repairs[0].Action != Repair_Delete {
+		t.Fatalf(`Expected single delete repair but got %v`, mismatch.Repairs)
+	}
+}
+
+func Test_Validate_reports_unclosed_at_end_of_input(t *testing.T) {
+	mismatch := mismatchOf(t, "{[(<", nil)
+
+	if mismatch.Kind != Mismatch_Unclosed {
+		t.Fatalf(`Expected Mismatch_Unclosed but got %v`, mismatch.Kind)
+	}
+	if mismatch.Expected != ">" || mismatch.Opened_At != (Position{Line: 1, Column: 4}) {
+		t.Fatalf(`Expected ">" for opener at column 4 but got %v`, mismatch)
+	}
+	if mismatch.Position != (Position{Line: 1, Column: 5}) {
+		t.Fatalf(`Expected end of input at column 5 but got %v`, mismatch.Position)
+	}
+
+	repair := Repair{Action: Repair_Insert, Position: Position{Line: 1, Column: 5}, Text: ">)]}"}
+	if len(mismatch.Repairs) != 1 || mismatch.Repairs[0] != repair {
+		t.Fatalf(`Expected %v but got %v`, repair, mismatch.Repairs)
+	}
+}
+
+func Test_Validate_handles_symmetric_delimiters(t *testing.T) {
+	delimiters := []Delimiter{{Open: "(", Close: ")"}, {Open: `"`, Close: `"`}}
+
+	if err := Validate(strings.NewReader(`f("a", ("b"))`), delimiters); err != nil {
+		t.Fatalf(`Unexpected error %v`, err)
+	}
+
+	mismatch := mismatchOf(t, `("a)`, delimiters)
+	if mismatch.Kind != Mismatch_Wrong_Closer || mismatch.Expected != `"` {
+		t.Fatalf(`Expected unclosed quote but got %v`, mismatch)
+	}
+}
+
+func Test_Validate_counts_columns_in_runes(t *testing.T) {
+	mismatch := mismatchOf(t, "héllo ]", nil)
+
+	if mismatch.Position != (Position{Line: 1, Column: 7}) {
+		t.Fatalf(`Expected column 7 but got %v`, mismatch.Position)
+	}
+}
+
+func Test_Validate_streams_through_small_reads(t *testing.T) {
+	delimiters := append([]Delimiter{{Open: "{{", Close: "}}"}}, Default_Delimiters...)
+	input := "{{ a }}\n{{ (b) }}\n{ ]"
+
+	mismatch := mismatchOf(t, input, delimiters)
+	err := Validate(iotest.OneByteReader(strings.NewReader(input)), delimiters)
+
+	var streamed *Mismatch_Error
+	if !errors.As(err, &streamed) || streamed.Position != mismatch.Position {
+		t.Fatalf(`Expected %v but got %v`, mismatch, err)
+	}
+}
+
+func Test_Validate_returns_reader_error(t *testing.T) {
+	err := Validate(iotest.ErrReader(errors.New("boom")), nil)
+
+	var mismatch *Mismatch_Error
+	if err == nil || errors.As(err, &mismatch) {
+		t.Fatalf(`Expected reader error but got %v`, err)
+	}
+}
+
+func Test_New_Tokenizer_rejects_empty_delimiter(t *testing.T) {
+	if _, err := New_Tokenizer(strings.NewReader(""), []Delimiter{{Open: "(", Close: ""}}); !errors.Is(err, ErrEmptyDelimiter) {
+		t.Fatalf(`Expected ErrEmptyDelimiter but got %v`, err)
+	}
+}
+
+func Test_Validate_large_input(t *testing.T) {
+	depth := 10000
+	input := strings.Repeat("([{", depth) + strings.Repeat("x\n", 1000) + strings.Repeat("}])", depth)
+
+	if err := Validate(strings.NewReader(input), nil); err != nil {
+		t.Fatalf(`Unexpected error %v`, err)
+	}
+
+	mismatch := mismatchOf(t, input+")", nil)
+	if mismatch.Kind != Mismatch_Unexpected_Closer || mismatch.Position.Line != 1001 {
+		t.Fatalf(`Expected unexpected closer on line 1001 but got %v`, mismatch)
+	}
+}
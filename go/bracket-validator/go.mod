@@ -0,0 +1,12 @@
+module bracket-validator
+
+go 1.23
+
+require stack v0.0.0
+
+require common-errors v0.0.0 // indirect
+
+replace (
+	common-errors => ../common-errors
+	stack => ../stack
+)
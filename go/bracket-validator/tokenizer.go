@@ -0,0 +1,164 @@
+package bracket_validator
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// Pair of opening and closing text, when both are equal, such as quotes, the
+// delimiter closes if it is innermost open and opens otherwise
+type Delimiter struct {
+	Open  string
+	Close string
+}
+
+// Returned by `New_Tokenizer` and `Validate` when a delimiter has empty open
+// or close text
+var ErrEmptyDelimiter = errors.New("Delimiters must not be empty")
+
+var Default_Delimiters = []Delimiter{
+	{Open: "(", Close: ")"},
+	{Open: "[", Close: "]"},
+	{Open: "{", Close: "}"},
+	{Open: "<", Close: ">"},
+}
+
+// 1-based line and column, columns count runes not bytes
+type Position struct {
+	Line   int
+	Column int
+}
+
+func (position Position) String() string {
+	return fmt.Sprintf("line %d, column %d", position.Line, position.Column)
+}
+
+// Delimiter found in input, `Opening` is false for closers
+//
+// @note - for symmetric delimiters `Opening` is always true, the validator
+// decides from context whether it actually closes
+type Token struct {
+	Text      string
+	Opening   bool
+	Delimiter Delimiter
+	Position  Position
+}
+
+type candidate struct {
+	text      string
+	opening   bool
+	delimiter Delimiter
+}
+
+// Streaming scanner yielding only delimiter tokens, everything else is
+// skipped while line and column are tracked, input is read through a small
+// buffer so it is never loaded whole
+type Tokenizer struct {
+	reader     *bufio.Reader
+	candidates []candidate
+	longest    int
+	position   Position
+}
+
+func New_Tokenizer(reader io.Reader, delimiters []Delimiter) (*Tokenizer, error) {
+	if len(delimiters) == 0 {
+		delimiters = Default_Delimiters
+	}
+
+	tokenizer := &Tokenizer{
+		position: Position{Line: 1, Column: 1},
+	}
+
+	for _, delimiter := range delimiters {
+		if delimiter.Open == "" || delimiter.Close == "" {
+			return nil, ErrEmptyDelimiter
+		}
+
+		tokenizer.candidates = append(tokenizer.candidates, candidate{
+			text:      delimiter.Open,
+			opening:   true,
+			delimiter: delimiter,
+		})
+		if delimiter.Close != delimiter.Open {
+			tokenizer.candidates = append(tokenizer.candidates, candidate{
+				text:      delimiter.Close,
+				delimiter: delimiter,
+			})
+		}
+
+		tokenizer.longest = max(tokenizer.longest, len(delimiter.Open), len(delimiter.Close))
+	}
+
+	// Longest match wins, so `{{` is not read as two `{`
+	sort.SliceStable(tokenizer.candidates, func(i, j int) bool {
+		return len(tokenizer.candidates[i].text) > len(tokenizer.candidates[j].text)
+	})
+
+	tokenizer.reader = bufio.NewReaderSize(reader, max(4096, tokenizer.longest))
+	return tokenizer, nil
+}
+
+/**
+ * Returns next delimiter, or `io.EOF` once input is exhausted
+ */
+func (tokenizer *Tokenizer) Next() (Token, error) {
+	for {
+		// Short peek at end of input is expected, shorter candidates may fit
+		window, err := tokenizer.reader.Peek(tokenizer.longest)
+		if len(window) == 0 {
+			if err == nil || errors.Is(err, io.EOF) || errors.Is(err, bufio.ErrBufferFull) {
+				return Token{}, io.EOF
+			}
+			return Token{}, err
+		}
+
+		for _, candidate := range tokenizer.candidates {
+			if len(window) < len(candidate.text) || string(window[:len(candidate.text)]) != candidate.text {
+				continue
+			}
+
+			token := Token{
+				Text:      candidate.text,
+				Opening:   candidate.opening,
+				Delimiter: candidate.delimiter,
+				Position:  tokenizer.position,
+			}
+			tokenizer.reader.Discard(len(candidate.text))
+			tokenizer.advance(candidate.text)
+			return token, nil
+		}
+
+		character, _, err := tokenizer.reader.ReadRune()
+		if err != nil {
+			return Token{}, err
+		}
+
+		if character == '\n' {
+			tokenizer.position.Line++
+			tokenizer.position.Column = 1
+		} else {
+			tokenizer.position.Column++
+		}
+	}
+}
+
+/**
+ * Returns position of next unread rune, after `io.EOF` this is end of input
+ */
+func (tokenizer *Tokenizer) Position() Position {
+	return tokenizer.position
+}
+
+func (tokenizer *Tokenizer) advance(text string) {
+	for _, character := range text {
+		if character == '\n' {
+			tokenizer.position.Line++
+			tokenizer.position.Column = 1
+		} else {
+			tokenizer.position.Column++
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package collections
+
+import (
+	"iter"
+	"slices"
+)
+
+// Every operation here is lazy unless noted, nothing is pulled from the
+// source until the result is ranged over, and stopping early stops the source
+
+/**
+ * Yield `transform(item)` for every item
+ *
+ * ## Example
+ *
+ *	doubled := Map(slices.Values([]int{1, 2}), func(n int) int { return n * 2 })
+ */
+func Map[T, U any](seq iter.Seq[T], transform func(T) U) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		for item := range seq {
+			if !yield(transform(item)) {
+				return
+			}
+		}
+	}
+}
+
+/**
+ * Yield only items where `keep(item)` is true
+ */
+func Filter[T any](seq iter.Seq[T], keep func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for item := range seq {
+			if keep(item) && !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+/**
+ * Yield every item of every sequence `expand` returns, in order
+ */
+func Flat_Map[T, U any](seq iter.Seq[T], expand func(T) iter.Seq[U]) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		for item := range seq {
+			for inner := range expand(item) {
+				if !yield(inner) {
+					return
+				}
+			}
+		}
+	}
+}
+
+/**
+ * Yield at most first `count` items, source is not read past them
+ */
+func Take[T any](seq iter.Seq[T], count uint) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if count == 0 {
+			return
+		}
+
+		taken := uint(0)
+		for item := range seq {
+			if !yield(item) {
+				return
+			}
+			taken++
+			if taken == count {
+				return
+			}
+		}
+	}
+}
+
+/**
+ * Yield every item after first `count`
+ */
+func Skip[T any](seq iter.Seq[T], count uint) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		skipped := uint(0)
+		for item := range seq {
+			if skipped < count {
+				skipped++
+				continue
+			}
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+/**
+ * Yield pairs of items at same position, stopping with the shorter sequence
+ *
+ * ## Example
+ *
+ *	for name, age := range Zip(names, ages) {
+ *		fmt.Println(name, age)
+ *	}
+ */
+func Zip[A, B any](left iter.Seq[A], right iter.Seq[B]) iter.Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		next, stop := iter.Pull(right)
+		defer stop()
+
+		for a := range left {
+			b, ok := next()
+			if !ok || !yield(a, b) {
+				return
+			}
+		}
+	}
+}
+
+/**
+ * Yield consecutive non-overlapping slices of `size` items, last may be short
+ *
+ * @note - each chunk is a new slice, safe to keep after iteration moves on
+ */
+func Chunk[T any](seq iter.Seq[T], size uint) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		if size == 0 {
+			return
+		}
+
+		chunk := make([]T, 0, size)
+		for item := range seq {
+			chunk = append(chunk, item)
+			if uint(len(chunk)) == size {
+				if !yield(chunk) {
+					return
+				}
+				chunk = make([]T, 0, size)
+			}
+		}
+
+		if len(chunk) > 0 {
+			yield(chunk)
+		}
+	}
+}
+
+/**
+ * Yield every run of `size` consecutive items, sliding by one, nothing when
+ * sequence is shorter than `size`
+ *
+ * @note - each window is a new slice, safe to keep after iteration moves on
+ */
+func Window[T any](seq iter.Seq[T], size uint) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		if size == 0 {
+			return
+		}
+
+		buffer := make([]T, 0, size)
+		for item := range seq {
+			if uint(len(buffer)) == size {
+				copy(buffer, buffer[1:])
+				buffer = buffer[:size-1]
+			}
+			buffer = append(buffer, item)
+
+			if uint(len(buffer)) == size && !yield(slices.Clone(buffer)) {
+				return
+			}
+		}
+	}
+}
+
+/**
+ * Fold every item into an accumulator starting from `initial`, eager
+ *
+ * ## Example
+ *
+ *	sum := Reduce(list.Values(), 0, func(total, n int) int { return total + n })
+ */
+func Reduce[T, U any](seq iter.Seq[T], initial U, combine func(U, T) U) U {
+	result := initial
+	for item := range seq {
+		result = combine(result, item)
+	}
+	return result
+}
+
+/**
+ * Collect items into slices keyed by `key(item)`, order within each group is
+ * sequence order, eager
+ */
+func Group_By[T any, K comparable](seq iter.Seq[T], key func(T) K) map[K][]T {
+	groups := map[K][]T{}
+	for item := range seq {
+		k := key(item)
+		groups[k] = append(groups[k], item)
+	}
+	return groups
+}
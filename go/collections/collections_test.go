@@ -0,0 +1,147 @@
+package collections
+
+import (
+	"iter"
+	"slices"
+	"testing"
+)
+
+// Sequence of 0 up to `count`, recording how many items were pulled so tests
+// can check laziness
+func counting(count int, pulled *int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i := 0; i < count; i++ {
+			*pulled++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+func Test_Map_Filter_Flat_Map(t *testing.T) {
+	pulled := 0
+	numbers := counting(10, &pulled)
+
+	evens := Filter(numbers, func(n int) bool { return n%2 == 0 })
+	squares := Map(evens, func(n int) int { return n * n })
+	if pulled != 0 {
+		t.Fatalf(`Expected nothing pulled before ranging, got %v`, pulled)
+	}
+
+	if result := slices.Collect(squares); !slices.Equal(result, []int{0, 4, 16, 36, 64}) {
+		t.Fatalf(`Expected even squares but got %v`, result)
+	}
+
+	repeated := Flat_Map(slices.Values([]int{1, 2, 3}), func(n int) iter.Seq[int] {
+		return Take(func(yield func(int) bool) {
+			for yield(n) {
+			}
+		}, uint(n))
+	})
+	if result := slices.Collect(repeated); !slices.Equal(result, []int{1, 2, 2, 3, 3, 3}) {
+		t.Fatalf(`Expected [1 2 2 3 3 3] but got %v`, result)
+	}
+}
+
+func Test_Take_and_Skip_stop_early(t *testing.T) {
+	pulled := 0
+	if result := slices.Collect(Take(counting(100, &pulled), 3)); !slices.Equal(result, []int{0, 1, 2}) {
+		t.Fatalf(`Expected [0 1 2] but got %v`, result)
+	}
+	if pulled != 3 {
+		t.Fatalf(`Expected Take to pull 3 items but pulled %v`, pulled)
+	}
+
+	pulled = 0
+	if result := slices.Collect(Take(counting(100, &pulled), 0)); len(result) != 0 || pulled != 0 {
+		t.Fatalf(`Expected Take 0 to pull nothing, got %v after %v pulls`, result, pulled)
+	}
+
+	pulled = 0
+	skipped := Take(Skip(counting(100, &pulled), 5), 2)
+	if result := slices.Collect(skipped); !slices.Equal(result, []int{5, 6}) || pulled != 7 {
+		t.Fatalf(`Expected [5 6] after 7 pulls but got %v after %v`, result, pulled)
+	}
+
+	if result := slices.Collect(Skip(slices.Values([]int{1, 2}), 5)); len(result) != 0 {
+		t.Fatalf(`Expected nothing left after skipping past end, got %v`, result)
+	}
+}
+
+func Test_Zip_stops_with_shorter(t *testing.T) {
+	names := slices.Values([]string{"a", "b", "c"})
+	pulled := 0
+
+	keys := []string{}
+	values := []int{}
+	for name, number := range Zip(names, counting(2, &pulled)) {
+		keys = append(keys, name)
+		values = append(values, number)
+	}
+
+	if !slices.Equal(keys, []string{"a", "b"}) || !slices.Equal(values, []int{0, 1}) {
+		t.Fatalf(`Expected a:0 b:1 but got %v %v`, keys, values)
+	}
+
+	pulled = 0
+	for range Zip(names, counting(100, &pulled)) {
+		break
+	}
+	if pulled != 1 {
+		t.Fatalf(`Expected breaking to stop right side, pulled %v`, pulled)
+	}
+}
+
+func Test_Chunk(t *testing.T) {
+	pulled := 0
+	chunks := slices.Collect(Chunk(counting(7, &pulled), 3))
+
+	if len(chunks) != 3 || !slices.Equal(chunks[0], []int{0, 1, 2}) || !slices.Equal(chunks[2], []int{6}) {
+		t.Fatalf(`Expected [[0 1 2] [3 4 5] [6]] but got %v`, chunks)
+	}
+	if len(slices.Collect(Chunk(counting(7, &pulled), 0))) != 0 {
+		t.Fatalf(`Expected no chunks of size 0`)
+	}
+	if len(slices.Collect(Chunk(counting(0, &pulled), 2))) != 0 {
+		t.Fatalf(`Expected no chunks from empty sequence`)
+	}
+}
+
+func Test_Window(t *testing.T) {
+	pulled := 0
+	windows := slices.Collect(Window(counting(5, &pulled), 3))
+
+	expected := [][]int{{0, 1, 2}, {1, 2, 3}, {2, 3, 4}}
+	if len(windows) != len(expected) {
+		t.Fatalf(`Expected %v but got %v`, expected, windows)
+	}
+	for i := range expected {
+		if !slices.Equal(windows[i], expected[i]) {
+			t.Fatalf(`Expected %v but got %v`, expected, windows)
+		}
+	}
+
+	if len(slices.Collect(Window(counting(2, &pulled), 3))) != 0 {
+		t.Fatalf(`Expected no windows from short sequence`)
+	}
+}
+
+func Test_Reduce_and_Group_By(t *testing.T) {
+	pulled := 0
+	sum := Reduce(counting(5, &pulled), 0, func(total, n int) int { return total + n })
+	if sum != 10 {
+		t.Fatalf(`Expected 10 but got %v`, sum)
+	}
+
+	words := slices.Values([]string{"apple", "avocado", "banana", "blueberry", "cherry"})
+	joined := Reduce(words, "", func(text, word string) string { return text + word[:1] })
+	if joined != "aabbc" {
+		t.Fatalf(`Expected "aabbc" but got %q`, joined)
+	}
+
+	groups := Group_By(words, func(word string) byte { return word[0] })
+	if len(groups) != 3 || !slices.Equal(groups['b'], []string{"banana", "blueberry"}) {
+		t.Fatalf(`Unexpected groups %v`, groups)
+	}
+}
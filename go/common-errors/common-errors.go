@@ -0,0 +1,89 @@
+package common_errors
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Sentinels shared by every package, match with `errors.Is`, the structured
+// types below unwrap to them and carry details for `errors.As`
+var (
+	ErrEmpty           = errors.New("empty")
+	ErrNotFound        = errors.New("not found")
+	ErrIndexOutOfRange = errors.New("index out of range")
+)
+
+// Container had nothing to return
+//
+// ## Example
+//
+//	_, err := queue.Deque()
+//	if errors.Is(err, common_errors.ErrEmpty) {
+//		// wait for more work
+//	}
+type Empty_Error struct {
+	// Kind of container, such as "Queue" or "Stack"
+	Container string
+}
+
+func (err *Empty_Error) Error() string {
+	return err.Container + " is empty"
+}
+
+func (err *Empty_Error) Unwrap() error {
+	return ErrEmpty
+}
+
+// Search finished without a match
+type Not_Found_Error struct {
+	// What was looked for, such as "Value" or "Node"
+	Target string
+
+	// Where it was looked for, such as "list" or "array"
+	Container string
+}
+
+func (err *Not_Found_Error) Error() string {
+	return err.Target + " not in " + err.Container
+}
+
+func (err *Not_Found_Error) Unwrap() error {
+	return ErrNotFound
+}
+
+// Index was past the end of a container
+//
+// ## Example
+//
+//	_, err := list.Get(10)
+//	var index_err *common_errors.Index_Error
+//	if errors.As(err, &index_err) {
+//		fmt.Println(index_err.Index, index_err.Length)
+//	}
+type Index_Error struct {
+	Index  uint
+	Length uint
+}
+
+func (err *Index_Error) Error() string {
+	return fmt.Sprintf("Index %d out of range for length %d", err.Index, err.Length)
+}
+
+func (err *Index_Error) Unwrap() error {
+	return ErrIndexOutOfRange
+}
+
+// Run of `Count` items from `Start` reached past the end of a container
+type Range_Error struct {
+	Start  uint
+	Count  uint
+	Length uint
+}
+
+func (err *Range_Error) Error() string {
+	return fmt.Sprintf("Range of %d from index %d out of range for length %d", err.Count, err.Start, err.Length)
+}
+
+func (err *Range_Error) Unwrap() error {
+	return ErrIndexOutOfRange
+}
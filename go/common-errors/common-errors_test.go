@@ -0,0 +1,43 @@
+package common_errors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func Test_structured_errors_match_sentinels(t *testing.T) {
+	cases := []struct {
+		err      error
+		sentinel error
+		message  string
+	}{
+		{&Empty_Error{Container: "Queue"}, ErrEmpty, "Queue is empty"},
+		{&Not_Found_Error{Target: "Value", Container: "list"}, ErrNotFound, "Value not in list"},
+		{&Index_Error{Index: 7, Length: 3}, ErrIndexOutOfRange, "Index 7 out of range for length 3"},
+		{&Range_Error{Start: 2, Count: 4, Length: 5}, ErrIndexOutOfRange, "Range of 4 from index 2 out of range for length 5"},
+	}
+
+	for _, c := range cases {
+		wrapped := fmt.Errorf("context: %w", c.err)
+		if !errors.Is(wrapped, c.sentinel) {
+			t.Fatalf(`Expected %v to match %v`, c.err, c.sentinel)
+		}
+		if c.err.Error() != c.message {
+			t.Fatalf(`Expected message %q but got %q`, c.message, c.err.Error())
+		}
+	}
+
+	if errors.Is(&Empty_Error{Container: "Stack"}, ErrNotFound) {
+		t.Fatalf(`Expected Empty_Error not to match ErrNotFound`)
+	}
+}
+
+func Test_Index_Error_carries_details(t *testing.T) {
+	err := fmt.Errorf("lookup failed: %w", &Index_Error{Index: 10, Length: 4})
+
+	var index_err *Index_Error
+	if !errors.As(err, &index_err) || index_err.Index != 10 || index_err.Length != 4 {
+		t.Fatalf(`Expected Index_Error with index 10 and length 4 but got %v`, err)
+	}
+}
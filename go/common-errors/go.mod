@@ -0,0 +1,3 @@
+module common-errors
+
+go 1.21.2
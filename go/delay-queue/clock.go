@@ -0,0 +1,145 @@
+package delay_queue
+
+import (
+	"sync"
+	"time"
+)
+
+// Source of time for `Delay_Queue`, swap in `Fake_Clock` for tests
+type Clock interface {
+	Now() time.Time
+	New_Timer(d time.Duration) Timer
+}
+
+// Single shot timer, mirrors the parts of `time.Timer` the queue needs
+type Timer interface {
+	Chan() <-chan time.Time
+	Stop() bool
+}
+
+// Wall clock backed by the `time` package
+type System_Clock struct{}
+
+func (System_Clock) Now() time.Time {
+	return time.Now()
+}
+
+func (System_Clock) New_Timer(d time.Duration) Timer {
+	return system_timer{timer: time.NewTimer(d)}
+}
+
+type system_timer struct {
+	timer *time.Timer
+}
+
+func (timer system_timer) Chan() <-chan time.Time {
+	return timer.timer.C
+}
+
+func (timer system_timer) Stop() bool {
+	return timer.timer.Stop()
+}
+
+// Manually driven clock, time only moves when `Advance` is called
+//
+// ## Example
+//
+//	clock := New_Fake_Clock(time.Unix(0, 0))
+//	queue := New_Delay_Queue[string](clock)
+//	queue.Enqueue_After("retry", time.Minute)
+//	go queue.Deque(ctx)
+//	clock.Block_Until_Timers(1)
+//	clock.Advance(time.Minute)
+type Fake_Clock struct {
+	mutex  sync.Mutex
+	cond   *sync.Cond
+	now    time.Time
+	timers []*fake_timer
+}
+
+type fake_timer struct {
+	clock    *Fake_Clock
+	deadline time.Time
+	channel  chan time.Time
+}
+
+func New_Fake_Clock(start time.Time) *Fake_Clock {
+	clock := &Fake_Clock{now: start}
+	clock.cond = sync.NewCond(&clock.mutex)
+	return clock
+}
+
+func (clock *Fake_Clock) Now() time.Time {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+	return clock.now
+}
+
+func (clock *Fake_Clock) New_Timer(d time.Duration) Timer {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+
+	timer := &fake_timer{
+		clock:    clock,
+		deadline: clock.now.Add(d),
+		channel:  make(chan time.Time, 1),
+	}
+
+	if d <= 0 {
+		timer.channel <- clock.now
+		return timer
+	}
+
+	clock.timers = append(clock.timers, timer)
+	clock.cond.Broadcast()
+	return timer
+}
+
+// Move time forward and fire every timer that has come due
+func (clock *Fake_Clock) Advance(d time.Duration) {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+
+	clock.now = clock.now.Add(d)
+
+	pending := clock.timers[:0]
+	for _, timer := range clock.timers {
+		if timer.deadline.After(clock.now) {
+			pending = append(pending, timer)
+			continue
+		}
+		timer.channel <- clock.now
+	}
+	clock.timers = pending
+	clock.cond.Broadcast()
+}
+
+// Wait until at least `count` timers are pending, so a test knows a blocked
+// `Deque` is parked before it calls `Advance`
+func (clock *Fake_Clock) Block_Until_Timers(count int) {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+
+	for len(clock.timers) < count {
+		clock.cond.Wait()
+	}
+}
+
+func (timer *fake_timer) Chan() <-chan time.Time {
+	return timer.channel
+}
+
+func (timer *fake_timer) Stop() bool {
+	clock := timer.clock
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+
+	for i, pending := range clock.timers {
+		if pending == timer {
+			clock.timers = append(clock.timers[:i], clock.timers[i+1:]...)
+			clock.cond.Broadcast()
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,242 @@
+package delay_queue
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	common_errors "common-errors"
+)
+
+// Returned by `Try_Deque` when items are queued but none has reached its
+// deadline
+var ErrNotDue = errors.New("No item is due")
+
+// Scheduled item, returned by `Enqueue_At` and `Enqueue_After` for `Cancel`
+type Handle[T any] struct {
+	item     T
+	deadline time.Time
+	sequence uint64
+
+	// Position within heap, `-1` once delivered or cancelled
+	index int
+}
+
+// Time at which item becomes available to `Deque`
+func (handle *Handle[T]) Deadline() time.Time {
+	return handle.deadline
+}
+
+// Queue that hides each item until its deadline has passed
+//
+// ## Example
+//
+//	queue := New_Delay_Queue[string](System_Clock{})
+//	queue.Enqueue_After("retry job 42", 30*time.Second)
+//	item, err := queue.Deque(ctx) // blocks for roughly 30 seconds
+//
+// @notes
+//
+// - Items live in a binary min-heap ordered by deadline, ties keep the
+// first-in first-out order of `queue.Queue` through a sequence number
+// - Zero value is ready to use and reads time from `System_Clock`
+// - Safe for concurrent use
+type Delay_Queue[T any] struct {
+	clock    Clock
+	mutex    sync.Mutex
+	heap     []*Handle[T]
+	sequence uint64
+
+	// Closed, then replaced, whenever earliest deadline may have changed
+	changed chan struct{}
+}
+
+func New_Delay_Queue[T any](clock Clock) *Delay_Queue[T] {
+	return &Delay_Queue[T]{clock: clock}
+}
+
+/**
+ * Schedule item to become available at deadline
+ */
+func (queue *Delay_Queue[T]) Enqueue_At(item T, deadline time.Time) *Handle[T] {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	queue.sequence++
+	handle := &Handle[T]{
+		item:     item,
+		deadline: deadline,
+		sequence: queue.sequence,
+		index:    len(queue.heap),
+	}
+
+	queue.heap = append(queue.heap, handle)
+	siftUp(queue.heap, handle.index)
+
+	if handle.index == 0 {
+		queue.notify()
+	}
+
+	return handle
+}
+
+/**
+ * Schedule item to become available once delay has elapsed
+ */
+func (queue *Delay_Queue[T]) Enqueue_After(item T, delay time.Duration) *Handle[T] {
+	return queue.Enqueue_At(item, queue.getClock().Now().Add(delay))
+}
+
+/**
+ * Remove scheduled item, returns false if it was already delivered or cancelled
+ */
+func (queue *Delay_Queue[T]) Cancel(handle *Handle[T]) bool {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	if handle.index < 0 || handle.index >= len(queue.heap) || queue.heap[handle.index] != handle {
+		return false
+	}
+
+	was_first := handle.index == 0
+	queue.remove(handle.index)
+
+	if was_first {
+		queue.notify()
+	}
+
+	return true
+}
+
+/**
+ * Remove and return next due item, blocking until one is due or ctx is done
+ */
+func (queue *Delay_Queue[T]) Deque(ctx context.Context) (T, error) {
+	clock := queue.getClock()
+
+	for {
+		queue.mutex.Lock()
+
+		if len(queue.heap) > 0 {
+			wait := queue.heap[0].deadline.Sub(clock.Now())
+			if wait <= 0 {
+				item := queue.remove(0).item
+				queue.mutex.Unlock()
+				return item, nil
+			}
+
+			changed := queue.getChanged()
+			queue.mutex.Unlock()
+
+			timer := clock.New_Timer(wait)
+			select {
+			case <-timer.Chan():
+			case <-changed:
+				timer.Stop()
+			case <-ctx.Done():
+				timer.Stop()
+				var result T
+				return result, ctx.Err()
+			}
+			continue
+		}
+
+		changed := queue.getChanged()
+		queue.mutex.Unlock()
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			var result T
+			return result, ctx.Err()
+		}
+	}
+}
+
+/**
+ * Remove and return next due item without blocking, errors with
+ * `*common_errors.Empty_Error` when nothing is queued or `ErrNotDue`
+ */
+func (queue *Delay_Queue[T]) Try_Deque() (T, error) {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	var result T
+	if len(queue.heap) == 0 {
+		return result, &common_errors.Empty_Error{Container: "Queue"}
+	}
+
+	if queue.heap[0].deadline.After(queue.getClock().Now()) {
+		return result, ErrNotDue
+	}
+
+	return queue.remove(0).item, nil
+}
+
+/**
+ * Returns item with earliest deadline, due or not, without mutation
+ */
+func (queue *Delay_Queue[T]) Peek() (T, error) {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	if len(queue.heap) == 0 {
+		var result T
+		return result, &common_errors.Empty_Error{Container: "Queue"}
+	}
+
+	return queue.heap[0].item, nil
+}
+
+/**
+ * Returns number of scheduled items, due or not
+ */
+func (queue *Delay_Queue[T]) Length() uint {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	return uint(len(queue.heap))
+}
+
+// @note - callers must hold mutex
+func (queue *Delay_Queue[T]) remove(index int) *Handle[T] {
+	handle := queue.heap[index]
+	last := len(queue.heap) - 1
+
+	swap(queue.heap, index, last)
+	queue.heap[last] = nil
+	queue.heap = queue.heap[:last]
+
+	if index < last {
+		siftDown(queue.heap, index)
+		siftUp(queue.heap, index)
+	}
+
+	handle.index = -1
+	return handle
+}
+
+// Wake every blocked `Deque` so it re-reads the earliest deadline
+//
+// @note - callers must hold mutex
+func (queue *Delay_Queue[T]) notify() {
+	if queue.changed != nil {
+		close(queue.changed)
+		queue.changed = nil
+	}
+}
+
+// @note - callers must hold mutex
+func (queue *Delay_Queue[T]) getChanged() chan struct{} {
+	if queue.changed == nil {
+		queue.changed = make(chan struct{})
+	}
+	return queue.changed
+}
+
+func (queue *Delay_Queue[T]) getClock() Clock {
+	if queue.clock == nil {
+		return System_Clock{}
+	}
+	return queue.clock
+}
@@ -0,0 +1,195 @@
+package delay_queue
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	common_errors "common-errors"
+)
+
+var epoch = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func Test_Try_Deque_hides_items_until_due(t *testing.T) {
+	clock := New_Fake_Clock(epoch)
+	queue := New_Delay_Queue[string](clock)
+
+	if _, err := queue.Try_Deque(); !errors.Is(err, common_errors.ErrEmpty) {
+		t.Fatalf(`Expected ErrEmpty for empty queue but got %v`, err)
+	}
+
+	queue.Enqueue_After("later", time.Minute)
+
+	if _, err := queue.Try_Deque(); !errors.Is(err, ErrNotDue) {
+		t.Fatalf(`Expected ErrNotDue before deadline but got %v`, err)
+	}
+	if queue.Length() != 1 {
+		t.Fatalf(`Expected queue.Length() of 1 but got %v`, queue.Length())
+	}
+
+	clock.Advance(time.Minute)
+
+	value, err := queue.Try_Deque()
+	if err != nil {
+		t.Fatalf(`Unexpected error %v`, err)
+	}
+	if value != "later" {
+		t.Fatalf(`Expected value "later" but got %v`, value)
+	}
+	if queue.Length() != 0 {
+		t.Fatalf(`Expected queue.Length() of 0 but got %v`, queue.Length())
+	}
+}
+
+func Test_Items_come_out_by_deadline_then_enqueue_order(t *testing.T) {
+	clock := New_Fake_Clock(epoch)
+	queue := New_Delay_Queue[int](clock)
+
+	queue.Enqueue_At(3, epoch.Add(3*time.Second))
+	queue.Enqueue_At(1, epoch.Add(1*time.Second))
+	queue.Enqueue_At(20, epoch.Add(2*time.Second))
+	queue.Enqueue_At(21, epoch.Add(2*time.Second))
+	queue.Enqueue_At(22, epoch.Add(2*time.Second))
+
+	peek, err := queue.Peek()
+	if err != nil || peek != 1 {
+		t.Fatalf(`Expected Peek of 1 but got %v with error %v`, peek, err)
+	}
+
+	clock.Advance(time.Hour)
+
+	for _, expected := range []int{1, 20, 21, 22, 3} {
+		value, err := queue.Try_Deque()
+		if err != nil {
+			t.Fatalf(`Unexpected error %v`, err)
+		}
+		if value != expected {
+			t.Fatalf(`Expected value %v but got %v`, expected, value)
+		}
+	}
+}
+
+func Test_Cancel_removes_scheduled_item(t *testing.T) {
+	clock := New_Fake_Clock(epoch)
+	queue := New_Delay_Queue[int](clock)
+
+	handles := make([]*Handle[int], 10)
+	for i := range handles {
+		handles[i] = queue.Enqueue_After(i, time.Duration(i)*time.Second)
+	}
+
+	for _, i := range []int{0, 4, 9} {
+		if !queue.Cancel(handles[i]) {
+			t.Fatalf(`Expected Cancel of %v to succeed`, i)
+		}
+		if queue.Cancel(handles[i]) {
+			t.Fatalf(`Expected second Cancel of %v to fail`, i)
+		}
+	}
+
+	clock.Advance(time.Minute)
+
+	for _, expected := range []int{1, 2, 3, 5, 6, 7, 8} {
+		value, err := queue.Try_Deque()
+		if err != nil {
+			t.Fatalf(`Unexpected error %v`, err)
+		}
+		if value != expected {
+			t.Fatalf(`Expected value %v but got %v`, expected, value)
+		}
+	}
+
+	if queue.Cancel(handles[1]) {
+		t.Fatalf(`Expected Cancel of delivered item to fail`)
+	}
+}
+
+func Test_Deque_blocks_until_deadline(t *testing.T) {
+	clock := New_Fake_Clock(epoch)
+	queue := New_Delay_Queue[string](clock)
+
+	queue.Enqueue_After("retry", time.Minute)
+
+	result := make(chan string)
+	go func() {
+		value, _ := queue.Deque(context.Background())
+		result <- value
+	}()
+
+	clock.Block_Until_Timers(1)
+
+	select {
+	case value := <-result:
+		t.Fatalf(`Deque returned %v before deadline`, value)
+	default:
+	}
+
+	clock.Advance(time.Minute)
+
+	if value := <-result; value != "retry" {
+		t.Fatalf(`Expected value "retry" but got %v`, value)
+	}
+}
+
+func Test_Deque_wakes_for_newly_enqueued_earlier_item(t *testing.T) {
+	clock := New_Fake_Clock(epoch)
+	queue := New_Delay_Queue[string](clock)
+
+	queue.Enqueue_After("slow", time.Hour)
+
+	result := make(chan string)
+	go func() {
+		value, _ := queue.Deque(context.Background())
+		result <- value
+	}()
+
+	clock.Block_Until_Timers(1)
+	queue.Enqueue_After("fast", time.Second)
+	clock.Advance(time.Second)
+
+	if value := <-result; value != "fast" {
+		t.Fatalf(`Expected value "fast" but got %v`, value)
+	}
+}
+
+func Test_Deque_on_empty_queue_waits_for_Enqueue(t *testing.T) {
+	queue := New_Delay_Queue[int](New_Fake_Clock(epoch))
+
+	result := make(chan int)
+	go func() {
+		value, _ := queue.Deque(context.Background())
+		result <- value
+	}()
+
+	queue.Enqueue_After(42, 0)
+
+	if value := <-result; value != 42 {
+		t.Fatalf(`Expected value 42 but got %v`, value)
+	}
+}
+
+func Test_Deque_returns_context_error_when_cancelled(t *testing.T) {
+	clock := New_Fake_Clock(epoch)
+	queue := New_Delay_Queue[int](clock)
+	queue.Enqueue_After(1, time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	result := make(chan error)
+	go func() {
+		_, err := queue.Deque(ctx)
+		result <- err
+	}()
+
+	clock.Block_Until_Timers(1)
+	cancel()
+
+	if err := <-result; !errors.Is(err, context.Canceled) {
+		t.Fatalf(`Expected context.Canceled but got %v`, err)
+	}
+
+	if queue.Length() != 1 {
+		t.Fatalf(`Expected item to remain queued but length is %v`, queue.Length())
+	}
+}
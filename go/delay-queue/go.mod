@@ -0,0 +1,7 @@
+module delay-queue
+
+go 1.21.2
+
+require common-errors v0.0.0
+
+replace common-errors => ../common-errors
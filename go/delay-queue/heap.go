@@ -0,0 +1,51 @@
+package delay_queue
+
+// Earlier deadline wins, equal deadlines fall back to enqueue order
+func less[T any](heap []*Handle[T], i, j int) bool {
+	if heap[i].deadline.Equal(heap[j].deadline) {
+		return heap[i].sequence < heap[j].sequence
+	}
+	return heap[i].deadline.Before(heap[j].deadline)
+}
+
+// Exchange two entries and keep their `index` fields in step
+func swap[T any](heap []*Handle[T], i, j int) {
+	heap[i], heap[j] = heap[j], heap[i]
+	heap[i].index = i
+	heap[j].index = j
+}
+
+// Move entry towards root while it is smaller than its parent
+func siftUp[T any](heap []*Handle[T], index int) {
+	for index > 0 {
+		parent := (index - 1) / 2
+		if !less(heap, index, parent) {
+			return
+		}
+		swap(heap, index, parent)
+		index = parent
+	}
+}
+
+// Move entry towards leaves while either child is smaller
+func siftDown[T any](heap []*Handle[T], index int) {
+	length := len(heap)
+	for {
+		smallest := index
+		left := 2*index + 1
+		right := left + 1
+
+		if left < length && less(heap, left, smallest) {
+			smallest = left
+		}
+		if right < length && less(heap, right, smallest) {
+			smallest = right
+		}
+		if smallest == index {
+			return
+		}
+
+		swap(heap, index, smallest)
+		index = smallest
+	}
+}
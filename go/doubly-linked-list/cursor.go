@@ -0,0 +1,229 @@
+package doubly_linked_list
+
+import (
+	"errors"
+
+	common_errors "common-errors"
+)
+
+// Returned by every `Cursor` method once its node has left the list
+var ErrInvalidCursor = errors.New("Cursor is invalid")
+
+// Returns value held by node
+func (node *Node[T]) Value() T {
+	return node.value
+}
+
+// Returns cursor positioned on node, invalid if node was already removed
+func (node *Node[T]) Cursor() *Cursor[T] {
+	return &Cursor[T]{node: node}
+}
+
+// Remove node in `O(1)` and return its value, errors when node is not in list
+func (list *List[T]) Remove_Node(node *Node[T]) (T, error) {
+	if node == nil || node.owningList() != list {
+		var result T
+		return result, &common_errors.Not_Found_Error{Target: "Node", Container: "list"}
+	}
+	return list.removeNode(node), nil
+}
+
+// Move node to head of list in `O(1)`, errors when node is not in list
+func (list *List[T]) Move_To_Front(node *Node[T]) error {
+	if node == nil || node.owningList() != list {
+		return &common_errors.Not_Found_Error{Target: "Node", Container: "list"}
+	}
+	if node == list.head {
+		return nil
+	}
+
+	list.unlink(node)
+	node.next = list.head
+	list.head.prev = node
+	list.head = node
+	return nil
+}
+
+// Move node to tail of list in `O(1)`, errors when node is not in list
+func (list *List[T]) Move_To_Back(node *Node[T]) error {
+	if node == nil || node.owningList() != list {
+		return &common_errors.Not_Found_Error{Target: "Node", Container: "list"}
+	}
+	if node == list.tail {
+		return nil
+	}
+
+	list.unlink(node)
+	node.prev = list.tail
+	list.tail.next = node
+	list.tail = node
+	return nil
+}
+
+// Detach node from its neighbours without changing `Length` or ownership,
+// callers must relink it
+//
+// @note - node must not be the only node in list
+func (list *List[T]) unlink(node *Node[T]) {
+	if node.prev != nil {
+		node.prev.next = node.next
+	} else {
+		list.head = node.next
+	}
+	if node.next != nil {
+		node.next.prev = node.prev
+	} else {
+		list.tail = node.prev
+	}
+	node.next = nil
+	node.prev = nil
+}
+
+// Position within a list allowing `O(1)` reads and edits where it points,
+// instead of walking from an end like `InsertAt` and `RemoveAt`
+//
+// ## Example
+//
+//	node := list.Append(2)
+//	cursor := node.Cursor()
+//	cursor.Insert_Before(1)
+//	cursor.Insert_After(3)
+//	cursor.Remove() // list is 1, 3 and cursor is on 3
+//
+// @notes
+//
+// - cursor becomes invalid once its node is removed through any other path,
+// e.g. `Remove`, `RemoveAt` or another cursor, every method then returns an
+// error or `false` instead of touching the list
+// - cursor follows its node when `Concat`, `Splice`, `Split_At` or
+// `Move_Range` hands it to another list, and edits that list from then on
+type Cursor[T any] struct {
+	node *Node[T]
+}
+
+// Returns cursor on head of list, invalid when list is empty
+func (list *List[T]) Cursor_Front() *Cursor[T] {
+	return &Cursor[T]{node: list.head}
+}
+
+// Returns cursor on tail of list, invalid when list is empty
+func (list *List[T]) Cursor_Back() *Cursor[T] {
+	return &Cursor[T]{node: list.tail}
+}
+
+// Report whether cursor points at a node still in a list
+func (cursor *Cursor[T]) Valid() bool {
+	return cursor.node != nil && cursor.node.owningList() != nil
+}
+
+// Returns node under cursor, or `nil` when invalid
+func (cursor *Cursor[T]) Node() *Node[T] {
+	if !cursor.Valid() {
+		return nil
+	}
+	return cursor.node
+}
+
+// Move toward tail, returns false and stays put when already at tail
+func (cursor *Cursor[T]) Next() bool {
+	if !cursor.Valid() || cursor.node.next == nil {
+		return false
+	}
+	cursor.node = cursor.node.next
+	return true
+}
+
+// Move toward head, returns false and stays put when already at head
+func (cursor *Cursor[T]) Prev() bool {
+	if !cursor.Valid() || cursor.node.prev == nil {
+		return false
+	}
+	cursor.node = cursor.node.prev
+	return true
+}
+
+// Returns value under cursor
+func (cursor *Cursor[T]) Value() (T, error) {
+	if !cursor.Valid() {
+		var result T
+		return result, ErrInvalidCursor
+	}
+	return cursor.node.value, nil
+}
+
+// Replace value under cursor
+func (cursor *Cursor[T]) Set(item T) error {
+	if !cursor.Valid() {
+		return ErrInvalidCursor
+	}
+	cursor.node.value = item
+	return nil
+}
+
+// Insert item in front of cursor and return its node, cursor does not move
+func (cursor *Cursor[T]) Insert_Before(item T) (*Node[T], error) {
+	if !cursor.Valid() {
+		return nil, ErrInvalidCursor
+	}
+
+	list := cursor.node.owningList()
+	mark := cursor.node
+	if mark.prev == nil {
+		return list.Prepend(item), nil
+	}
+
+	node := &Node[T]{
+		value: item,
+		next:  mark,
+		prev:  mark.prev,
+		owner: list.ownerOf(),
+	}
+	mark.prev.next = node
+	mark.prev = node
+	list.Length++
+
+	return node, nil
+}
+
+// Insert item behind cursor and return its node, cursor does not move
+func (cursor *Cursor[T]) Insert_After(item T) (*Node[T], error) {
+	if !cursor.Valid() {
+		return nil, ErrInvalidCursor
+	}
+
+	list := cursor.node.owningList()
+	mark := cursor.node
+	if mark.next == nil {
+		return list.Append(item), nil
+	}
+
+	node := &Node[T]{
+		value: item,
+		next:  mark.next,
+		prev:  mark,
+		owner: list.ownerOf(),
+	}
+	mark.next.prev = node
+	mark.next = node
+	list.Length++
+
+	return node, nil
+}
+
+// Remove node under cursor and return its value, cursor moves to the next
+// node, or previous node when removing tail, and is invalid once list empties
+func (cursor *Cursor[T]) Remove() (T, error) {
+	if !cursor.Valid() {
+		var result T
+		return result, ErrInvalidCursor
+	}
+
+	list := cursor.node.owningList()
+	node := cursor.node
+	cursor.node = node.next
+	if cursor.node == nil {
+		cursor.node = node.prev
+	}
+
+	return list.removeNode(node), nil
+}
@@ -0,0 +1,234 @@
+package doubly_linked_list
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	common_errors "common-errors"
+)
+
+func checkLinks[T comparable](t *testing.T, list *Doubly_Linked_List[T], expected []T) {
+	t.Helper()
+
+	if list.Length != uint(len(expected)) {
+		t.Fatalf(`Expected length %v but got %v`, len(expected), list.Length)
+	}
+	if values := slices.Collect(list.Values()); !slices.Equal(values, expected) {
+		t.Fatalf(`Expected %v from head but got %v`, expected, values)
+	}
+
+	backward := []T{}
+	for _, value := range list.Backward() {
+		backward = append(backward, value)
+	}
+	slices.Reverse(backward)
+	if !slices.Equal(backward, expected) {
+		t.Fatalf(`Expected %v from tail but got %v`, expected, backward)
+	}
+}
+
+func Test_Append_and_Prepend_return_nodes(t *testing.T) {
+	list := &Doubly_Linked_List[int]{}
+	two := list.Append(2)
+	one := list.Prepend(1)
+
+	if one.Value() != 1 || two.Value() != 2 {
+		t.Fatalf(`Expected nodes holding 1 and 2 but got %v and %v`, one.Value(), two.Value())
+	}
+
+	cursor := two.Cursor()
+	if !cursor.Prev() || cursor.Node() != one {
+		t.Fatalf(`Expected cursor to move from node 2 to node 1`)
+	}
+}
+
+func Test_Cursor_moves_and_stops_at_ends(t *testing.T) {
+	list := listOf(1, 2, 3)
+	cursor := list.Cursor_Front()
+
+	if cursor.Prev() {
+		t.Fatalf(`Expected Prev to fail at head`)
+	}
+
+	values := []int{}
+	for {
+		value, _ := cursor.Value()
+		values = append(values, value)
+		if !cursor.Next() {
+			break
+		}
+	}
+	if !slices.Equal(values, []int{1, 2, 3}) {
+		t.Fatalf(`Expected [1 2 3] but got %v`, values)
+	}
+	if value, _ := cursor.Value(); value != 3 {
+		t.Fatalf(`Expected cursor to stay on tail but got %v`, value)
+	}
+
+	if list.Cursor_Back().Node() != cursor.Node() {
+		t.Fatalf(`Expected Cursor_Back on tail`)
+	}
+}
+
+func Test_Cursor_on_empty_list_is_invalid(t *testing.T) {
+	list := &Doubly_Linked_List[int]{}
+	cursor := list.Cursor_Front()
+
+	if cursor.Valid() || cursor.Next() || cursor.Prev() {
+		t.Fatalf(`Expected invalid cursor on empty list`)
+	}
+	if _, err := cursor.Value(); !errors.Is(err, ErrInvalidCursor) {
+		t.Fatalf(`Expected error reading invalid cursor`)
+	}
+	if _, err := cursor.Insert_After(1); !errors.Is(err, ErrInvalidCursor) || list.Length != 0 {
+		t.Fatalf(`Expected error inserting through invalid cursor`)
+	}
+}
+
+func Test_Cursor_inserts_around_position(t *testing.T) {
+	list := listOf(2, 4)
+	cursor := list.Cursor_Front()
+
+	cursor.Insert_Before(1)
+	cursor.Insert_After(3)
+	checkLinks(t, list, []int{1, 2, 3, 4})
+
+	cursor = list.Cursor_Back()
+	cursor.Insert_After(6)
+	cursor.Insert_Before(35)
+	cursor.Set(5)
+	checkLinks(t, list, []int{1, 2, 3, 35, 5, 6})
+
+	if value, _ := list.Get(5); value != 6 {
+		t.Fatalf(`Expected 6 at tail but got %v`, value)
+	}
+}
+
+func Test_Cursor_Remove_moves_to_neighbour(t *testing.T) {
+	list := listOf(1, 2, 3)
+	cursor := list.Cursor_Front()
+	cursor.Next()
+
+	if value, err := cursor.Remove(); err != nil || value != 2 {
+		t.Fatalf(`Expected to remove 2 but got %v, %v`, value, err)
+	}
+	if value, _ := cursor.Value(); value != 3 {
+		t.Fatalf(`Expected cursor on 3 but got %v`, value)
+	}
+
+	cursor.Remove()
+	if value, _ := cursor.Value(); value != 1 {
+		t.Fatalf(`Expected cursor on 1 after removing tail but got %v`, value)
+	}
+
+	cursor.Remove()
+	if cursor.Valid() || list.Length != 0 {
+		t.Fatalf(`Expected invalid cursor and empty list`)
+	}
+	checkLinks(t, list, []int{})
+
+	list.Append(7)
+	checkLinks(t, list, []int{7})
+}
+
+func Test_Cursor_is_invalidated_by_other_removals(t *testing.T) {
+	list := listOf(1, 2, 3)
+	node := list.Cursor_Front().Node()
+	cursor := node.Cursor()
+	other := list.Cursor_Front()
+
+	list.Remove(1)
+	if cursor.Valid() || cursor.Next() {
+		t.Fatalf(`Expected cursor invalid after Remove`)
+	}
+	if err := cursor.Set(9); !errors.Is(err, ErrInvalidCursor) {
+		t.Fatalf(`Expected error setting through invalid cursor`)
+	}
+	if _, err := other.Remove(); !errors.Is(err, ErrInvalidCursor) || list.Length != 2 {
+		t.Fatalf(`Expected error removing through stale cursor`)
+	}
+	if node.Cursor().Valid() {
+		t.Fatalf(`Expected cursor from removed node to be invalid`)
+	}
+
+	last := list.Cursor_Back()
+	list.RemoveAt(1)
+	if last.Valid() {
+		t.Fatalf(`Expected cursor invalid after RemoveAt`)
+	}
+
+	single := listOf(5)
+	only := single.Cursor_Front()
+	single.Remove(5)
+	if only.Valid() {
+		t.Fatalf(`Expected cursor invalid after emptying list`)
+	}
+
+	decoded := listOf(1)
+	stale := decoded.Cursor_Front()
+	decoded.UnmarshalJSON([]byte("[2]"))
+	if stale.Valid() {
+		t.Fatalf(`Expected cursor invalid after list is decoded over`)
+	}
+}
+
+func Test_Cursor_follows_node_to_another_list(t *testing.T) {
+	list := listOf(1, 2)
+	other := listOf(3, 4)
+	cursor := other.Cursor_Front()
+
+	list.Concat(&other.List)
+	if !cursor.Valid() {
+		t.Fatalf(`Expected cursor valid after Concat moved its node`)
+	}
+	cursor.Insert_Before(9)
+	checkLinks(t, list, []int{1, 2, 9, 3, 4})
+
+	tail, err := list.Split_At(3)
+	if err != nil {
+		t.Fatalf(`Unexpected error %v`, err)
+	}
+	if value, err := cursor.Remove(); err != nil || value != 3 {
+		t.Fatalf(`Expected to remove 3 through cursor but got %v, %v`, value, err)
+	}
+	checkLinks(t, list, []int{1, 2, 9})
+	checkLinks(t, tail, []int{4})
+}
+
+func Test_Move_To_Front_and_Move_To_Back(t *testing.T) {
+	list := &Doubly_Linked_List[int]{}
+	one := list.Append(1)
+	two := list.Append(2)
+	three := list.Append(3)
+
+	list.Move_To_Front(three)
+	checkLinks(t, list, []int{3, 1, 2})
+
+	list.Move_To_Front(three)
+	list.Move_To_Front(two)
+	checkLinks(t, list, []int{2, 3, 1})
+
+	list.Move_To_Back(two)
+	list.Move_To_Back(two)
+	checkLinks(t, list, []int{3, 1, 2})
+
+	list.Move_To_Back(three)
+	checkLinks(t, list, []int{1, 2, 3})
+
+	if value, err := list.Remove_Node(one); err != nil || value != 1 {
+		t.Fatalf(`Expected to remove 1 but got %v, %v`, value, err)
+	}
+	checkLinks(t, list, []int{2, 3})
+
+	other := &Doubly_Linked_List[int]{}
+	if err := other.Move_To_Front(two); !errors.Is(err, common_errors.ErrNotFound) {
+		t.Fatalf(`Expected error moving node of another list`)
+	}
+	if err := list.Move_To_Back(one); !errors.Is(err, common_errors.ErrNotFound) {
+		t.Fatalf(`Expected error moving removed node`)
+	}
+	if _, err := list.Remove_Node(one); !errors.Is(err, common_errors.ErrNotFound) {
+		t.Fatalf(`Expected error removing node twice`)
+	}
+}
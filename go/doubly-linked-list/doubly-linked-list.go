@@ -1,40 +1,58 @@
 package doubly_linked_list
 
 import (
-	"errors"
+	common_errors "common-errors"
 )
 
-// Holds value and pointers to next/previous nodes
-type Node[T comparable] struct {
+// Holds value and pointers to next/previous nodes, returned by `Append` and
+// `Prepend` as a handle for `O(1)` edits through `Cursor`
+type Node[T any] struct {
 	value T
 	next  *Node[T]
 	prev  *Node[T]
+
+	// Resolves to owning list, `nil` once node is removed so stale handles
+	// are detected
+	owner *node_owner[T]
 }
 
 // Holds index and value data for iterators to pass via channels
-type Index_Value[T comparable] struct {
-	index int
-	value T
+type Index_Value[T any] struct {
+	Index int
+	Value T
 }
 
-// Holds length and pointers to head/tail nodes
-type Doubly_Linked_List[T comparable] struct {
+// Holds length and pointers to head/tail nodes, accepts any item type so
+// searching and removal go through the `*_Func` predicate methods
+//
+// @note - must not be copied after first use, nodes record the list they
+// belong to so a copy rejects them and panics on insert, read only use of a
+// copy such as encoding is fine
+type List[T any] struct {
 	Length uint
 	head   *Node[T]
 	tail   *Node[T]
+	owner  *node_owner[T]
+}
+
+// List of comparable items, adds `==` based conveniences such as `Remove`
+// and `Contains` on top of everything `List` offers
+type Doubly_Linked_List[T comparable] struct {
+	List[T]
 }
 
-// Insert item at head of list
-func (list *Doubly_Linked_List[T]) Prepend(item T) {
+// Insert item at head of list and return its node
+func (list *List[T]) Prepend(item T) *Node[T] {
 	node := Node[T]{
 		value: item,
+		owner: list.ownerOf(),
 	}
 
 	list.Length++
 	if list.Length == 1 {
 		list.head = &node
 		list.tail = &node
-		return
+		return &node
 	}
 
 	// Attach node to list
@@ -43,11 +61,14 @@ func (list *Doubly_Linked_List[T]) Prepend(item T) {
 	// Attach list to node
 	list.head.prev = &node
 	list.head = &node
+	return &node
 }
 
-func (list *Doubly_Linked_List[T]) InsertAt(item T, index uint) (any, error) {
+// Insert item before position `index`, an index equal to `Length` appends,
+// errors with `*common_errors.Index_Error` past that
+func (list *List[T]) InsertAt(item T, index uint) (any, error) {
 	if index > list.Length {
-		return nil, errors.New("Index greater than list length")
+		return nil, &common_errors.Index_Error{Index: index, Length: list.Length}
 	} else if index == list.Length {
 		list.Append(item)
 		return nil, nil
@@ -65,6 +86,7 @@ func (list *Doubly_Linked_List[T]) InsertAt(item T, index uint) (any, error) {
 		value: item,
 		next:  curr,
 		prev:  curr.prev,
+		owner: list.ownerOf(),
 	}
 
 	// Attach list to node
@@ -76,17 +98,18 @@ func (list *Doubly_Linked_List[T]) InsertAt(item T, index uint) (any, error) {
 	return nil, nil
 }
 
-// Insert item at head of list
-func (list *Doubly_Linked_List[T]) Append(item T) {
+// Insert item at tail of list and return its node
+func (list *List[T]) Append(item T) *Node[T] {
 	node := Node[T]{
 		value: item,
+		owner: list.ownerOf(),
 	}
 
 	list.Length++
 	if list.tail == nil {
 		list.head = &node
 		list.tail = &node
-		return
+		return &node
 	}
 
 	// Attach node to list
@@ -95,31 +118,11 @@ func (list *Doubly_Linked_List[T]) Append(item T) {
 	// Attach list to node
 	list.tail.next = &node
 	list.tail = &node
-}
-
-// Traverse list, from head to tail, and remove first node with matching value
-func (list *Doubly_Linked_List[T]) Remove(item T) (T, error) {
-	if list.Length == 0 {
-		var result T
-		return result, errors.New("List is empty")
-	}
-
-	node := list.head
-	for i := uint(0); i < list.Length; i++ {
-		if node != nil {
-			if item == node.value {
-				return list.removeNode(node), nil
-			}
-			node = node.next
-		}
-	}
-
-	var result T
-	return result, errors.New("Value not in list")
+	return &node
 }
 
 // Traverse list and attempt to retrieve value at given index
-func (list *Doubly_Linked_List[T]) Get(index uint) (T, error) {
+func (list *List[T]) Get(index uint) (T, error) {
 	node, err := list.getAt(index)
 	if err != nil {
 		var result T
@@ -129,7 +132,7 @@ func (list *Doubly_Linked_List[T]) Get(index uint) (T, error) {
 }
 
 // Traverse list and attempt to remove node at given index
-func (list *Doubly_Linked_List[T]) RemoveAt(index uint) (T, error) {
+func (list *List[T]) RemoveAt(index uint) (T, error) {
 	node, err := list.getAt(index)
 	if err != nil {
 		var result T
@@ -141,14 +144,17 @@ func (list *Doubly_Linked_List[T]) RemoveAt(index uint) (T, error) {
 
 // Iterate from head, to tail, and communicate index/value pares over channel
 //
+// @note - prefer `All`, which needs no goroutine and cannot leak when `done`
+// is never closed
+//
 // ## Example
 //
 //	done := make(chan struct{})
 //	defer close(done)
 //	for iv := range list.Iter_Entries(done) {
-//		fmt.Println("iv.index ->", iv.index, "iv.value ->", iv.value)
+//		fmt.Println("iv.Index ->", iv.Index, "iv.Value ->", iv.Value)
 //	}
-func (list *Doubly_Linked_List[T]) Iter_Entries(done <-chan struct{}) <-chan Index_Value[T] {
+func (list *List[T]) Iter_Entries(done <-chan struct{}) <-chan Index_Value[T] {
 	channel := make(chan Index_Value[T])
 
 	go func() {
@@ -158,8 +164,8 @@ func (list *Doubly_Linked_List[T]) Iter_Entries(done <-chan struct{}) <-chan Ind
 		for node != nil {
 			select {
 			case channel <- Index_Value[T]{
-				index: index,
-				value: node.value,
+				Index: index,
+				Value: node.value,
 			}:
 			case <-done:
 				return
@@ -174,8 +180,9 @@ func (list *Doubly_Linked_List[T]) Iter_Entries(done <-chan struct{}) <-chan Ind
 
 // Return value of node after updating connections and list pointers
 // @note - Callers must preform bounds checks or return value checks
-func (list *Doubly_Linked_List[T]) removeNode(node *Node[T]) T {
+func (list *List[T]) removeNode(node *Node[T]) T {
 	list.Length--
+	node.owner = nil
 	if list.Length == 0 {
 		list.head = nil
 		list.tail = nil
@@ -206,11 +213,11 @@ func (list *Doubly_Linked_List[T]) removeNode(node *Node[T]) T {
 }
 
 // Traverse list from end closest to target index
-func (list *Doubly_Linked_List[T]) getAt(index uint) (*Node[T], error) {
+func (list *List[T]) getAt(index uint) (*Node[T], error) {
 	if list.Length == 0 {
-		return nil, errors.New("List is empty")
-	} else if index > list.Length {
-		return nil, errors.New("Index greater than list length")
+		return nil, &common_errors.Empty_Error{Container: "List"}
+	} else if index >= list.Length {
+		return nil, &common_errors.Index_Error{Index: index, Length: list.Length}
 	}
 
 	if index < list.Length/2 {
@@ -232,5 +239,5 @@ func (list *Doubly_Linked_List[T]) getAt(index uint) (*Node[T], error) {
 	}
 
 	// We should never reach this branch and is only here to satisfy type checker
-	return nil, errors.New("Oh no!")
+	return nil, &common_errors.Index_Error{Index: index, Length: list.Length}
 }
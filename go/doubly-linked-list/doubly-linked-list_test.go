@@ -1,7 +1,10 @@
 package doubly_linked_list
 
 import (
+	"errors"
 	"testing"
+
+	common_errors "common-errors"
 )
 
 func Test_Prepend(t *testing.T) {
@@ -20,9 +23,9 @@ func Test_Prepend(t *testing.T) {
 	done := make(chan struct{})
 	defer close(done)
 	for iv := range list.Iter_Entries(done) {
-		expected := items[iv.index]
-		if iv.value != expected {
-			t.Fatalf(`Expected value %v did not match value %v at index %v`, expected, iv.value, iv.index)
+		expected := items[iv.Index]
+		if iv.Value != expected {
+			t.Fatalf(`Expected value %v did not match value %v at index %v`, expected, iv.Value, iv.Index)
 		}
 	}
 }
@@ -30,11 +33,12 @@ func Test_Prepend(t *testing.T) {
 func Test_InsertAt_errors_as_expected(t *testing.T) {
 	list := Doubly_Linked_List[int]{}
 
-	expected := "Index greater than list length"
 	var item int
 	_, err := list.InsertAt(item, list.Length+1)
-	if err.Error() != expected {
-		t.Fatalf(`Unexpected error message -> %v`, err)
+
+	var index_err *common_errors.Index_Error
+	if !errors.As(err, &index_err) || index_err.Index != 1 || index_err.Length != 0 {
+		t.Fatalf(`Expected *Index_Error for index 1 and length 0 but got %v`, err)
 	}
 }
 
@@ -52,9 +56,9 @@ func Test_InsertAt_appends_when_index_equals_list_length(t *testing.T) {
 	done := make(chan struct{})
 	defer close(done)
 	for iv := range list.Iter_Entries(done) {
-		expected := items[iv.index]
-		if iv.value != expected {
-			t.Fatalf(`Expected value %v did not match value %v at index %v`, expected, iv.value, iv.index)
+		expected := items[iv.Index]
+		if iv.Value != expected {
+			t.Fatalf(`Expected value %v did not match value %v at index %v`, expected, iv.Value, iv.Index)
 		}
 	}
 }
@@ -75,9 +79,9 @@ func Test_InsertAt_prepends_when_index_equals_zero(t *testing.T) {
 	done := make(chan struct{})
 	defer close(done)
 	for iv := range list.Iter_Entries(done) {
-		expected := items[iv.index]
-		if iv.value != expected {
-			t.Fatalf(`Expected value %v did not match value %v at index %v`, expected, iv.value, iv.index)
+		expected := items[iv.Index]
+		if iv.Value != expected {
+			t.Fatalf(`Expected value %v did not match value %v at index %v`, expected, iv.Value, iv.Index)
 		}
 	}
 }
@@ -129,9 +133,9 @@ func Test_Append(t *testing.T) {
 	done := make(chan struct{})
 	defer close(done)
 	for iv := range list.Iter_Entries(done) {
-		expected := items[iv.index]
-		if iv.value != expected {
-			t.Fatalf(`Expected value %v did not match value %v at index %v`, expected, iv.value, iv.index)
+		expected := items[iv.Index]
+		if iv.Value != expected {
+			t.Fatalf(`Expected value %v did not match value %v at index %v`, expected, iv.Value, iv.Index)
 		}
 	}
 }
@@ -139,13 +143,12 @@ func Test_Append(t *testing.T) {
 func Test_Remove_erros_on_empty_list(t *testing.T) {
 	list := Doubly_Linked_List[int]{}
 
-	expected_error := "List is empty"
 	var expected_value int
 
 	item := 1337
 	value, err := list.Remove(item)
-	if err.Error() != expected_error {
-		t.Fatalf(`Unexpected error message -> %v`, err)
+	if !errors.Is(err, common_errors.ErrEmpty) {
+		t.Fatalf(`Expected ErrEmpty but got %v`, err)
 	}
 	if value != expected_value {
 		t.Fatalf(`Unexpected value -> %v`, value)
@@ -186,24 +189,22 @@ func Test_Remove_erros_when_item_not_in_list(t *testing.T) {
 		list.Append(value)
 	}
 
-	expected := "Value not in list"
 	item := 1337
 	_, err := list.Remove(item)
-	if err.Error() != expected {
-		t.Fatalf(`Unexpected error message -> %v`, err)
+	if !errors.Is(err, common_errors.ErrNotFound) {
+		t.Fatalf(`Expected ErrNotFound but got %v`, err)
 	}
 }
 
 func Test_Get_erros_on_empty_list(t *testing.T) {
 	list := Doubly_Linked_List[int]{}
 
-	expected := "List is empty"
 	var expected_value int
 
 	index := uint(0)
 	value, err := list.Get(index)
-	if err.Error() != expected {
-		t.Fatalf(`Unexpected error message -> %v`, err)
+	if !errors.Is(err, common_errors.ErrEmpty) {
+		t.Fatalf(`Expected ErrEmpty but got %v`, err)
 	}
 	if value != expected_value {
 		t.Fatalf(`Unexpected value -> %v`, value)
@@ -221,19 +222,31 @@ func Test_Get_erros_when_index_is_greater_than_list_length(t *testing.T) {
 		list.Append(value)
 	}
 
-	expected := "Index greater than list length"
 	var expected_value int
 
 	index := uint(1337)
 	value, err := list.Get(index)
-	if err.Error() != expected {
-		t.Fatalf(`Unexpected error message -> %v`, err)
+
+	var index_err *common_errors.Index_Error
+	if !errors.As(err, &index_err) || index_err.Index != index || index_err.Length != list.Length {
+		t.Fatalf(`Expected *Index_Error for index %v and length %v but got %v`, index, list.Length, err)
 	}
 	if value != expected_value {
 		t.Fatalf(`Unexpected value -> %v`, value)
 	}
 }
 
+func Test_Get_errors_when_index_equals_list_length(t *testing.T) {
+	list := Doubly_Linked_List[int]{}
+	list.Append(1)
+	list.Append(2)
+
+	_, err := list.Get(list.Length)
+	if !errors.Is(err, common_errors.ErrIndexOutOfRange) {
+		t.Fatalf(`Expected ErrIndexOutOfRange but got %v`, err)
+	}
+}
+
 func Test_Get_returns_expected_values(t *testing.T) {
 	list := Doubly_Linked_List[int]{}
 
@@ -260,13 +273,12 @@ func Test_Get_returns_expected_values(t *testing.T) {
 func Test_RemoveAt_errors_on_empty_list(t *testing.T) {
 	list := Doubly_Linked_List[int]{}
 
-	expected := "List is empty"
 	var expected_value int
 
 	index := uint(0)
 	value, err := list.RemoveAt(index)
-	if err.Error() != expected {
-		t.Fatalf(`Unexpected error message -> %v`, err)
+	if !errors.Is(err, common_errors.ErrEmpty) {
+		t.Fatalf(`Expected ErrEmpty but got %v`, err)
 	}
 	if value != expected_value {
 		t.Fatalf(`Unexpected value -> %v`, value)
@@ -284,13 +296,14 @@ func Test_RemoveAt_errors_when_index_is_greater_than_list_length(t *testing.T) {
 		list.Append(value)
 	}
 
-	expected := "Index greater than list length"
 	var expected_value int
 
 	index := uint(1337)
 	value, err := list.RemoveAt(index)
-	if err.Error() != expected {
-		t.Fatalf(`Unexpected error message -> %v`, err)
+
+	var index_err *common_errors.Index_Error
+	if !errors.As(err, &index_err) || index_err.Index != index || index_err.Length != list.Length {
+		t.Fatalf(`Expected *Index_Error for index %v and length %v but got %v`, index, list.Length, err)
 	}
 	if value != expected_value {
 		t.Fatalf(`Unexpected value -> %v`, value)
@@ -359,8 +372,24 @@ func Test_Iter_Entries_allows_early_breaking(t *testing.T) {
 	done := make(chan struct{})
 	defer close(done)
 	for iv := range list.Iter_Entries(done) {
-		if iv.index > limit/2 {
+		if iv.Index > limit/2 {
 			break
 		}
 	}
 }
+
+func Test_copied_list_panics_on_insert(t *testing.T) {
+	list := Doubly_Linked_List[int]{}
+	list.Append(1)
+
+	copied := list
+	defer func() {
+		if recover() == nil {
+			t.Fatalf(`Expected insert into copied list to panic`)
+		}
+		if list.Length != 1 {
+			t.Fatalf(`Expected original list untouched but got length %v`, list.Length)
+		}
+	}()
+	copied.Append(2)
+}
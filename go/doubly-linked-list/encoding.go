@@ -0,0 +1,97 @@
+package doubly_linked_list
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// Values from head to tail
+func (list List[T]) items() []T {
+	items := make([]T, 0, list.Length)
+	for node := list.head; node != nil; node = node.next {
+		items = append(items, node.value)
+	}
+	return items
+}
+
+// Drop current nodes and append items in order
+func (list *List[T]) reset(items []T) {
+	// Detach old nodes so outstanding cursors see them as removed
+	list.disown()
+	*list = List[T]{}
+	for _, item := range items {
+		list.Append(item)
+	}
+}
+
+// Encode as JSON array from head to tail, value receiver so lists encode the
+// same whether passed to `json.Marshal` by value or by pointer
+func (list List[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(list.items())
+}
+
+// Replace contents with JSON array ordered from head to tail
+func (list *List[T]) UnmarshalJSON(data []byte) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("List expects a JSON array: %w", err)
+	}
+
+	items := make([]T, len(raw))
+	for i, message := range raw {
+		if err := json.Unmarshal(message, &items[i]); err != nil {
+			return fmt.Errorf("Cannot decode list item %d: %w", i, err)
+		}
+	}
+
+	list.reset(items)
+	return nil
+}
+
+// Encode with `encoding/gob` as item count followed by items from head to
+// tail, errors on items gob cannot encode, such as nil pointers or structs
+// without exported fields
+func (list List[T]) MarshalBinary() ([]byte, error) {
+	buffer := bytes.Buffer{}
+	encoder := gob.NewEncoder(&buffer)
+
+	if err := encoder.Encode(list.Length); err != nil {
+		return nil, err
+	}
+	for i, item := range list.items() {
+		// Gob panics rather than erroring on a nil pointer at top level
+		if value := reflect.ValueOf(item); value.Kind() == reflect.Pointer && value.IsNil() {
+			return nil, fmt.Errorf("Cannot encode list item %d: nil pointer", i)
+		}
+		if err := encoder.Encode(item); err != nil {
+			return nil, fmt.Errorf("Cannot encode list item %d: %w", i, err)
+		}
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// Replace contents with data produced by `MarshalBinary`
+func (list *List[T]) UnmarshalBinary(data []byte) error {
+	decoder := gob.NewDecoder(bytes.NewReader(data))
+
+	var length uint
+	if err := decoder.Decode(&length); err != nil {
+		return fmt.Errorf("Cannot decode list length: %w", err)
+	}
+
+	items := []T{}
+	for i := uint(0); i < length; i++ {
+		var item T
+		if err := decoder.Decode(&item); err != nil {
+			return fmt.Errorf("Cannot decode list item %d of %d: %w", i, length, err)
+		}
+		items = append(items, item)
+	}
+
+	list.reset(items)
+	return nil
+}
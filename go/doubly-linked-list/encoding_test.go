@@ -0,0 +1,132 @@
+package doubly_linked_list
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func Test_JSON_round_trip_keeps_order(t *testing.T) {
+	list := Doubly_Linked_List[int]{}
+	list.Append(2)
+	list.Append(3)
+	list.Prepend(1)
+
+	data, err := json.Marshal(&list)
+	if err != nil {
+		t.Fatalf(`Unexpected error %v`, err)
+	}
+	if string(data) != "[1,2,3]" {
+		t.Fatalf(`Expected [1,2,3] but got %s`, data)
+	}
+
+	decoded := Doubly_Linked_List[int]{}
+	decoded.Append(99)
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf(`Unexpected error %v`, err)
+	}
+	if decoded.Length != 3 {
+		t.Fatalf(`Expected length 3 but got %v`, decoded.Length)
+	}
+
+	for i, expected := range []int{1, 2, 3} {
+		if value, _ := decoded.Get(uint(i)); value != expected {
+			t.Fatalf(`Expected %v at %v but got %v`, expected, i, value)
+		}
+	}
+	if value, _ := decoded.RemoveAt(2); value != 3 {
+		t.Fatalf(`Expected tail of 3 but got %v`, value)
+	}
+}
+
+func Test_JSON_encodes_list_passed_by_value(t *testing.T) {
+	list := Doubly_Linked_List[int]{}
+	list.Append(1)
+	list.Append(2)
+
+	data, err := json.Marshal(list)
+	if err != nil || string(data) != "[1,2]" {
+		t.Fatalf(`Expected [1,2] but got %s, %v`, data, err)
+	}
+
+	// Fields reached through a value are not addressable either
+	wrapper := struct{ Items List[int] }{}
+	wrapper.Items.Append(3)
+	data, err = json.Marshal(wrapper)
+	if err != nil || string(data) != `{"Items":[3]}` {
+		t.Fatalf(`Expected {"Items":[3]} but got %s, %v`, data, err)
+	}
+}
+
+func Test_UnmarshalJSON_reports_bad_item(t *testing.T) {
+	decoded := Doubly_Linked_List[int]{}
+
+	err := json.Unmarshal([]byte(`[1, 2.5]`), &decoded)
+	if err == nil || !strings.Contains(err.Error(), "item 1") {
+		t.Fatalf(`Expected error naming item 1 but got %v`, err)
+	}
+
+	err = json.Unmarshal([]byte(`null`), &decoded)
+	if err != nil || decoded.Length != 0 {
+		t.Fatalf(`Expected null to decode as empty list but got %v, %v`, decoded.Length, err)
+	}
+}
+
+func Test_binary_round_trip_keeps_order(t *testing.T) {
+	list := Doubly_Linked_List[string]{}
+	list.Append("a")
+	list.Append("b")
+	list.Append("c")
+
+	data, err := list.MarshalBinary()
+	if err != nil {
+		t.Fatalf(`Unexpected error %v`, err)
+	}
+
+	decoded := Doubly_Linked_List[string]{}
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Fatalf(`Unexpected error %v`, err)
+	}
+	if decoded.Length != 3 {
+		t.Fatalf(`Expected length 3 but got %v`, decoded.Length)
+	}
+	if value, _ := decoded.Get(2); value != "c" {
+		t.Fatalf(`Expected "c" at tail but got %v`, value)
+	}
+}
+
+func Test_UnmarshalBinary_reports_bad_data(t *testing.T) {
+	list := Doubly_Linked_List[int]{}
+	list.Append(1)
+	list.Append(2)
+	data, _ := list.MarshalBinary()
+
+	decoded := Doubly_Linked_List[int]{}
+	err := decoded.UnmarshalBinary(data[:len(data)-1])
+	if err == nil || !strings.Contains(err.Error(), "item 1 of 2") {
+		t.Fatalf(`Expected error naming item 1 of 2 but got %v`, err)
+	}
+}
+
+func Test_MarshalBinary_reports_items_gob_cannot_encode(t *testing.T) {
+	type unexported struct{ value int }
+	hidden := List[unexported]{}
+	hidden.Append(unexported{1})
+	if _, err := hidden.MarshalBinary(); err == nil || !strings.Contains(err.Error(), "item 0") {
+		t.Fatalf(`Expected error naming item 0 but got %v`, err)
+	}
+
+	value := 1
+	pointers := List[*int]{}
+	pointers.Append(&value)
+	pointers.Append(nil)
+	if _, err := pointers.MarshalBinary(); err == nil || !strings.Contains(err.Error(), "item 1") {
+		t.Fatalf(`Expected error naming item 1 but got %v`, err)
+	}
+
+	boxed := List[any]{}
+	boxed.Append((*int)(nil))
+	if _, err := boxed.MarshalBinary(); err == nil || !strings.Contains(err.Error(), "item 0") {
+		t.Fatalf(`Expected error naming item 0 but got %v`, err)
+	}
+}
@@ -1,3 +1,7 @@
 module doubly-linked-list
 
-go 1.21.2
+go 1.23
+
+require common-errors v0.0.0
+
+replace common-errors => ../common-errors
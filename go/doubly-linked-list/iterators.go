@@ -0,0 +1,94 @@
+package doubly_linked_list
+
+import "iter"
+
+// Iterate from head, to tail, yielding index/value pairs without a goroutine
+//
+// ## Example
+//
+//	for index, value := range list.All() {
+//		fmt.Println("index ->", index, "value ->", value)
+//	}
+//
+// @notes
+//
+// - Next node is read before yielding, so removing the current item, e.g.
+// `list.RemoveAt(index)`, is safe and iteration continues with its successor
+// - Indexes count items visited, so they drift after such removals
+// - Any other mutation during iteration has undefined results
+func (list *List[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		index := 0
+		for node := list.head; node != nil; index++ {
+			next := node.next
+			if !yield(index, node.value) {
+				return
+			}
+			node = next
+		}
+	}
+}
+
+// Iterate from tail, to head, yielding index/value pairs, index of tail is
+// `Length - 1` as it would be for `Get`
+//
+// ## Example
+//
+//	for index, value := range list.Backward() {
+//		fmt.Println("index ->", index, "value ->", value)
+//	}
+//
+// @note - same mutation rules as `All`, removing the current item is safe and
+// does not disturb indexes of items still to come
+func (list *List[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		index := int(list.Length) - 1
+		for node := list.tail; node != nil; index-- {
+			prev := node.prev
+			if !yield(index, node.value) {
+				return
+			}
+			node = prev
+		}
+	}
+}
+
+// Iterate values from head, to tail
+//
+// ## Example
+//
+//	values := slices.Collect(list.Values())
+//
+// @note - same mutation rules as `All`
+func (list *List[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, value := range list.All() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Remove and yield values from head until list is empty, stopping early
+// leaves the rest
+func (list *List[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for list.Length > 0 {
+			if !yield(list.removeNode(list.head)) {
+				return
+			}
+		}
+	}
+}
+
+// Append every value of sequence in order
+//
+// ## Example
+//
+//	list.Append_All(collections.Filter(other.Values(), isValid))
+func (list *List[T]) Append_All(seq iter.Seq[T]) {
+	for value := range seq {
+		list.Append(value)
+	}
+}
@@ -0,0 +1,157 @@
+package doubly_linked_list
+
+import (
+	"slices"
+	"testing"
+)
+
+func listOf(values ...int) *Doubly_Linked_List[int] {
+	list := &Doubly_Linked_List[int]{}
+	for _, value := range values {
+		list.Append(value)
+	}
+	return list
+}
+
+func Test_All_yields_indexes_and_values_from_head(t *testing.T) {
+	list := listOf(10, 11, 12, 13)
+
+	expected := 0
+	for index, value := range list.All() {
+		if index != expected || value != expected+10 {
+			t.Fatalf(`Expected %v at %v but got %v at %v`, expected+10, expected, value, index)
+		}
+		expected++
+	}
+	if expected != 4 {
+		t.Fatalf(`Expected 4 iterations but got %v`, expected)
+	}
+}
+
+func Test_Backward_yields_indexes_and_values_from_tail(t *testing.T) {
+	list := listOf(10, 11, 12, 13)
+
+	expected := 3
+	for index, value := range list.Backward() {
+		if index != expected || value != expected+10 {
+			t.Fatalf(`Expected %v at %v but got %v at %v`, expected+10, expected, value, index)
+		}
+		expected--
+	}
+	if expected != -1 {
+		t.Fatalf(`Expected 4 iterations but got %v`, 3-expected)
+	}
+}
+
+func Test_Values_collects_in_order(t *testing.T) {
+	values := slices.Collect(listOf(3, 1, 2).Values())
+
+	if !slices.Equal(values, []int{3, 1, 2}) {
+		t.Fatalf(`Expected [3 1 2] but got %v`, values)
+	}
+	if values := slices.Collect((&Doubly_Linked_List[int]{}).Values()); len(values) != 0 {
+		t.Fatalf(`Expected no values but got %v`, values)
+	}
+}
+
+func Test_iterators_stop_early(t *testing.T) {
+	list := listOf(1, 2, 3, 4, 5)
+
+	count := 0
+	for range list.All() {
+		count++
+		if count == 2 {
+			break
+		}
+	}
+	for range list.Backward() {
+		count++
+		if count == 4 {
+			break
+		}
+	}
+	for range list.Values() {
+		count++
+		break
+	}
+
+	if count != 5 {
+		t.Fatalf(`Expected 5 iterations but got %v`, count)
+	}
+}
+
+func Test_All_allows_removing_current_item(t *testing.T) {
+	list := listOf(1, 2, 3, 4, 5, 6)
+
+	for _, value := range list.All() {
+		if value%2 == 0 {
+			list.Remove(value)
+		}
+	}
+
+	if values := slices.Collect(list.Values()); !slices.Equal(values, []int{1, 3, 5}) {
+		t.Fatalf(`Expected [1 3 5] but got %v`, values)
+	}
+}
+
+func Test_Backward_allows_removing_current_item(t *testing.T) {
+	list := listOf(1, 2, 3, 4, 5, 6)
+
+	for index, value := range list.Backward() {
+		if value%3 == 0 {
+			list.RemoveAt(uint(index))
+		}
+	}
+
+	if values := slices.Collect(list.Values()); !slices.Equal(values, []int{1, 2, 4, 5}) {
+		t.Fatalf(`Expected [1 2 4 5] but got %v`, values)
+	}
+}
+
+func Benchmark_All(b *testing.B) {
+	list := listOf(make([]int, 1000)...)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		sum := 0
+		for _, value := range list.All() {
+			sum += value
+		}
+	}
+}
+
+func Benchmark_Iter_Entries(b *testing.B) {
+	list := listOf(make([]int, 1000)...)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		sum := 0
+		done := make(chan struct{})
+		for iv := range list.Iter_Entries(done) {
+			sum += iv.Value
+		}
+		close(done)
+	}
+}
+
+func Test_Drain_and_Append_All(t *testing.T) {
+	list := &Doubly_Linked_List[int]{}
+	list.Append_All(slices.Values([]int{1, 2, 3, 4}))
+
+	drained := []int{}
+	for value := range list.Drain() {
+		drained = append(drained, value)
+		if value == 2 {
+			break
+		}
+	}
+	if !slices.Equal(drained, []int{1, 2}) {
+		t.Fatalf(`Expected [1 2] drained but got %v`, drained)
+	}
+	checkLinks(t, list, []int{3, 4})
+
+	other := &Doubly_Linked_List[int]{}
+	other.Append_All(list.Drain())
+	checkLinks(t, list, []int{})
+	checkLinks(t, other, []int{3, 4})
+}
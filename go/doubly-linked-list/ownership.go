@@ -0,0 +1,65 @@
+package doubly_linked_list
+
+// Shared record nodes point at instead of pointing at their list directly,
+// so moving every node of one list into another is `O(1)`, the old record is
+// forwarded to the new one, union-find style, rather than touching each node
+type node_owner[T any] struct {
+	list    *List[T]
+	forward *node_owner[T]
+}
+
+// Follow forwards to the live record, halving the path on the way so
+// repeated lookups stay cheap
+func (owner *node_owner[T]) resolve() *node_owner[T] {
+	for owner.forward != nil {
+		if owner.forward.forward != nil {
+			owner.forward = owner.forward.forward
+		}
+		owner = owner.forward
+	}
+	return owner
+}
+
+// Returns list node currently belongs to, or `nil` once removed
+func (node *Node[T]) owningList() *List[T] {
+	if node.owner == nil {
+		return nil
+	}
+	return node.owner.resolve().list
+}
+
+// Returns live owner record of list, created on first use so the zero value
+// list stays usable
+//
+// @note - panics when list is a copy, since the record still names the
+// original and new nodes would be handed to it
+func (list *List[T]) ownerOf() *node_owner[T] {
+	if list.owner == nil {
+		list.owner = &node_owner[T]{list: list}
+	}
+	if list.owner.list != list {
+		panic("doubly_linked_list: List copied after first use")
+	}
+	return list.owner
+}
+
+// Hand every node of list to `other` in `O(1)`, list keeps its nodes until
+// caller clears them
+func (list *List[T]) forwardTo(other *List[T]) {
+	if list.owner == nil {
+		return
+	}
+	list.owner.list = nil
+	list.owner.forward = other.ownerOf()
+	list.owner = nil
+}
+
+// Invalidate every node of list in `O(1)`, list keeps its nodes until caller
+// clears them
+func (list *List[T]) disown() {
+	if list.owner == nil {
+		return
+	}
+	list.owner.list = nil
+	list.owner = nil
+}
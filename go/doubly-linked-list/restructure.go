@@ -0,0 +1,253 @@
+package doubly_linked_list
+
+import (
+	"errors"
+
+	common_errors "common-errors"
+)
+
+var (
+	// Returned by `Splice` when asked to splice a list into itself
+	ErrSelfSplice = errors.New("Cannot splice list into itself")
+
+	// Returned by `Move_Range` when given no destination list
+	ErrNilDestination = errors.New("Destination list is nil")
+)
+
+// Move every node of `other` to end of list in `O(1)`, `other` is left empty
+// and its node handles now belong to list
+//
+// ## Example
+//
+//	list.Concat(&other.List)
+func (list *List[T]) Concat(other *List[T]) {
+	if other == nil || other == list || other.Length == 0 {
+		return
+	}
+
+	if list.Length == 0 {
+		list.head = other.head
+	} else {
+		list.tail.next = other.head
+		other.head.prev = list.tail
+	}
+	list.tail = other.tail
+	list.Length += other.Length
+
+	other.forwardTo(list)
+	other.clear()
+}
+
+// Move every node of `other` in front of node `at` in `O(1)`, a `nil` node
+// appends like `Concat`, `other` is left empty
+func (list *List[T]) Splice(at *Node[T], other *List[T]) error {
+	if other == list {
+		return ErrSelfSplice
+	} else if at == nil {
+		list.Concat(other)
+		return nil
+	} else if at.owningList() != list {
+		return &common_errors.Not_Found_Error{Target: "Node", Container: "list"}
+	} else if other == nil || other.Length == 0 {
+		return nil
+	}
+
+	before := at.prev
+	other.head.prev = before
+	if before == nil {
+		list.head = other.head
+	} else {
+		before.next = other.head
+	}
+	other.tail.next = at
+	at.prev = other.tail
+	list.Length += other.Length
+
+	other.forwardTo(list)
+	other.clear()
+	return nil
+}
+
+// Cut list before `index` and return nodes from `index` onward as new list,
+// cost is `O(min(index, Length - index))`
+func (list *List[T]) Split_At(index uint) (*List[T], error) {
+	result := &List[T]{}
+	if err := list.splitAt(index, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Same as `List.Split_At` but result keeps the comparable conveniences
+func (list *Doubly_Linked_List[T]) Split_At(index uint) (*Doubly_Linked_List[T], error) {
+	result := &Doubly_Linked_List[T]{}
+	if err := list.splitAt(index, &result.List); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Move `count` items starting at `start` to `dest`, inserted before position
+// `index` of `dest` as it is once the range is removed, `dest` may be list
+// itself, cost is `O(start + count + index)`
+//
+// ## Example
+//
+//	// list is a, b, c, d and other is x, y
+//	list.Move_Range(1, 2, &other.List, 1)
+//	// list is a, d and other is x, b, c, y
+func (list *List[T]) Move_Range(start, count uint, dest *List[T], index uint) error {
+	if dest == nil {
+		return ErrNilDestination
+	} else if start > list.Length || count > list.Length-start {
+		return &common_errors.Range_Error{Start: start, Count: count, Length: list.Length}
+	}
+
+	dest_length := dest.Length
+	if dest == list {
+		dest_length -= count
+	}
+	if index > dest_length {
+		return &common_errors.Index_Error{Index: index, Length: dest_length}
+	} else if count == 0 {
+		return nil
+	}
+
+	// Detach range, handing each node to `dest` on the way past
+	first, _ := list.getAt(start)
+	last := first
+	owner := dest.ownerOf()
+	for i := uint(1); ; i++ {
+		last.owner = owner
+		if i == count {
+			break
+		}
+		last = last.next
+	}
+
+	if first.prev == nil {
+		list.head = last.next
+	} else {
+		first.prev.next = last.next
+	}
+	if last.next == nil {
+		list.tail = first.prev
+	} else {
+		last.next.prev = first.prev
+	}
+	list.Length -= count
+	first.prev = nil
+	last.next = nil
+
+	// Attach range
+	if index == dest.Length {
+		if dest.Length == 0 {
+			dest.head = first
+		} else {
+			dest.tail.next = first
+			first.prev = dest.tail
+		}
+		dest.tail = last
+	} else {
+		at, _ := dest.getAt(index)
+		first.prev = at.prev
+		if at.prev == nil {
+			dest.head = first
+		} else {
+			at.prev.next = first
+		}
+		last.next = at
+		at.prev = last
+	}
+	dest.Length += count
+
+	return nil
+}
+
+// Reverse order of list in place in `O(n)`, node handles stay valid
+func (list *List[T]) Reverse() {
+	for node := list.head; node != nil; node = node.prev {
+		node.next, node.prev = node.prev, node.next
+	}
+	list.head, list.tail = list.tail, list.head
+}
+
+// Rotate list right by `k`, so last `k` items move to front, negative `k`
+// rotates left, cost is `O(min(k, Length - k))` after reducing `k` modulo
+// `Length`
+func (list *List[T]) Rotate(k int) {
+	if list.Length < 2 {
+		return
+	}
+
+	length := int(list.Length)
+	shift := ((k % length) + length) % length
+	if shift == 0 {
+		return
+	}
+
+	head, _ := list.getAt(uint(length - shift))
+
+	// Close ring, then open it in front of new head
+	list.tail.next = list.head
+	list.head.prev = list.tail
+	list.tail = head.prev
+	list.tail.next = nil
+	head.prev = nil
+	list.head = head
+}
+
+// Cut list before `index` and hand nodes from `index` onward to empty `into`,
+// whichever side is shorter gets a new owner record so cost is
+// `O(min(index, Length - index))`
+func (list *List[T]) splitAt(index uint, into *List[T]) error {
+	if index > list.Length {
+		return &common_errors.Index_Error{Index: index, Length: list.Length}
+	}
+
+	moved := list.Length - index
+	if moved == 0 {
+		return nil
+	} else if index == 0 {
+		into.Concat(list)
+		return nil
+	}
+
+	node, _ := list.getAt(index)
+	into.head = node
+	into.tail = list.tail
+	into.Length = moved
+
+	list.tail = node.prev
+	list.tail.next = nil
+	node.prev = nil
+	list.Length = index
+
+	if moved <= index {
+		owner := into.ownerOf()
+		for node := into.head; node != nil; node = node.next {
+			node.owner = owner
+		}
+		return nil
+	}
+
+	// Moved side is longer, so it keeps old owner record and list takes a
+	// new one
+	owner := list.ownerOf()
+	owner.list = into
+	into.owner = owner
+	list.owner = nil
+
+	owner = list.ownerOf()
+	for node := list.head; node != nil; node = node.next {
+		node.owner = owner
+	}
+	return nil
+}
+
+// Forget nodes, caller is responsible for their ownership
+func (list *List[T]) clear() {
+	list.head = nil
+	list.tail = nil
+	list.Length = 0
+}
@@ -0,0 +1,282 @@
+package doubly_linked_list
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	common_errors "common-errors"
+)
+
+// Check links, ends, `Length` and ownership of every node against expected
+func checkInvariants(t *testing.T, label string, list *List[int], expected []int) {
+	t.Helper()
+
+	values := []int{}
+	var prev *Node[int]
+	for node := list.head; node != nil; node = node.next {
+		if node.prev != prev {
+			t.Fatalf(`%v: broken prev link at %v`, label, node.value)
+		}
+		if node.owningList() != list {
+			t.Fatalf(`%v: node %v not owned by list`, label, node.value)
+		}
+		values = append(values, node.value)
+		prev = node
+	}
+	if list.tail != prev {
+		t.Fatalf(`%v: tail does not match last node`, label)
+	}
+	if list.Length != uint(len(values)) {
+		t.Fatalf(`%v: length %v but counted %v`, label, list.Length, len(values))
+	}
+	if !slices.Equal(values, expected) {
+		t.Fatalf(`%v: expected %v but got %v`, label, expected, values)
+	}
+}
+
+func rangeList(from, to int) (*List[int], []*Node[int]) {
+	list := &List[int]{}
+	nodes := []*Node[int]{}
+	for value := from; value < to; value++ {
+		nodes = append(nodes, list.Append(value))
+	}
+	return list, nodes
+}
+
+func rangeSlice(from, to int) []int {
+	values := []int{}
+	for value := from; value < to; value++ {
+		values = append(values, value)
+	}
+	return values
+}
+
+func Test_Concat_every_length(t *testing.T) {
+	for n := 0; n < 5; n++ {
+		for m := 0; m < 5; m++ {
+			label := fmt.Sprint("concat ", n, " ", m)
+			list, _ := rangeList(0, n)
+			other, nodes := rangeList(10, 10+m)
+
+			list.Concat(other)
+
+			checkInvariants(t, label, list, append(rangeSlice(0, n), rangeSlice(10, 10+m)...))
+			checkInvariants(t, label, other, []int{})
+			for _, node := range nodes {
+				if !node.Cursor().Valid() || node.owningList() != list {
+					t.Fatalf(`%v: moved node %v not usable from list`, label, node.value)
+				}
+			}
+
+			other.Append(99)
+			checkInvariants(t, label, other, []int{99})
+		}
+	}
+
+	list, _ := rangeList(0, 3)
+	list.Concat(list)
+	list.Concat(nil)
+	checkInvariants(t, "concat self", list, []int{0, 1, 2})
+}
+
+func Test_Concat_chains_keep_handles_valid(t *testing.T) {
+	lists := []*List[int]{}
+	handles := []*Node[int]{}
+	for i := 0; i < 20; i++ {
+		list, nodes := rangeList(i*2, i*2+2)
+		lists = append(lists, list)
+		handles = append(handles, nodes...)
+	}
+
+	// Fold right to left so every owner record is forwarded repeatedly
+	for i := len(lists) - 2; i >= 0; i-- {
+		lists[i].Concat(lists[i+1])
+	}
+
+	checkInvariants(t, "chain", lists[0], rangeSlice(0, 40))
+	for _, node := range handles {
+		if node.owningList() != lists[0] {
+			t.Fatalf(`Expected node %v owned by first list`, node.value)
+		}
+	}
+}
+
+func Test_Splice_every_position(t *testing.T) {
+	for n := 1; n < 5; n++ {
+		for m := 0; m < 4; m++ {
+			for at := 0; at <= n; at++ {
+				label := fmt.Sprint("splice ", n, " ", m, " at ", at)
+				list, nodes := rangeList(0, n)
+				other, _ := rangeList(10, 10+m)
+
+				var mark *Node[int]
+				if at < n {
+					mark = nodes[at]
+				}
+				if err := list.Splice(mark, other); err != nil {
+					t.Fatalf(`%v: unexpected error %v`, label, err)
+				}
+
+				expected := slices.Concat(rangeSlice(0, at), rangeSlice(10, 10+m), rangeSlice(at, n))
+				checkInvariants(t, label, list, expected)
+				checkInvariants(t, label, other, []int{})
+			}
+		}
+	}
+
+	list, nodes := rangeList(0, 2)
+	other, _ := rangeList(10, 12)
+	if err := list.Splice(nodes[0], list); !errors.Is(err, ErrSelfSplice) {
+		t.Fatalf(`Expected error splicing list into itself`)
+	}
+	if err := other.Splice(nodes[0], list); !errors.Is(err, common_errors.ErrNotFound) {
+		t.Fatalf(`Expected error splicing at node of another list`)
+	}
+	checkInvariants(t, "splice errors", list, []int{0, 1})
+}
+
+func Test_Split_At_every_index(t *testing.T) {
+	for n := 0; n < 8; n++ {
+		for index := 0; index <= n; index++ {
+			label := fmt.Sprint("split ", n, " at ", index)
+			list, nodes := rangeList(0, n)
+
+			tail, err := list.Split_At(uint(index))
+			if err != nil {
+				t.Fatalf(`%v: unexpected error %v`, label, err)
+			}
+
+			checkInvariants(t, label, list, rangeSlice(0, index))
+			checkInvariants(t, label, tail, rangeSlice(index, n))
+			for i, node := range nodes {
+				owner := list
+				if i >= index {
+					owner = tail
+				}
+				if node.owningList() != owner {
+					t.Fatalf(`%v: node %v has wrong owner`, label, i)
+				}
+			}
+
+			list.Append(100)
+			tail.Prepend(-1)
+			checkInvariants(t, label, list, append(rangeSlice(0, index), 100))
+			checkInvariants(t, label, tail, append([]int{-1}, rangeSlice(index, n)...))
+		}
+
+		list, _ := rangeList(0, n)
+		if _, err := list.Split_At(uint(n + 1)); !errors.Is(err, common_errors.ErrIndexOutOfRange) {
+			t.Fatalf(`Expected error splitting past end of %v`, n)
+		}
+	}
+}
+
+func Test_Doubly_Linked_List_Split_At_keeps_type(t *testing.T) {
+	list := listOf(1, 2, 3, 2)
+
+	tail, err := list.Split_At(2)
+	if err != nil || !tail.Contains(3) || tail.Index_Of(2) != 1 {
+		t.Fatalf(`Expected comparable tail holding 3, 2 but got %v`, err)
+	}
+	checkLinks(t, list, []int{1, 2})
+}
+
+func Test_Move_Range_every_combination(t *testing.T) {
+	for n := 0; n < 6; n++ {
+		for start := 0; start <= n; start++ {
+			for count := 0; start+count <= n; count++ {
+				moved := rangeSlice(start, start+count)
+				rest := slices.Concat(rangeSlice(0, start), rangeSlice(start+count, n))
+
+				// Into another list
+				for index := 0; index <= 3; index++ {
+					label := fmt.Sprint("move ", n, " ", start, "+", count, " to other at ", index)
+					list, _ := rangeList(0, n)
+					other, _ := rangeList(10, 13)
+
+					if err := list.Move_Range(uint(start), uint(count), other, uint(index)); err != nil {
+						t.Fatalf(`%v: unexpected error %v`, label, err)
+					}
+
+					checkInvariants(t, label, list, rest)
+					checkInvariants(t, label, other, slices.Concat(rangeSlice(10, 10+index), moved, rangeSlice(10+index, 13)))
+				}
+
+				// Within same list
+				for index := 0; index <= len(rest); index++ {
+					label := fmt.Sprint("move ", n, " ", start, "+", count, " within at ", index)
+					list, _ := rangeList(0, n)
+
+					if err := list.Move_Range(uint(start), uint(count), list, uint(index)); err != nil {
+						t.Fatalf(`%v: unexpected error %v`, label, err)
+					}
+
+					checkInvariants(t, label, list, slices.Concat(rest[:index], moved, rest[index:]))
+				}
+			}
+		}
+	}
+}
+
+func Test_Move_Range_errors_leave_lists_untouched(t *testing.T) {
+	list, _ := rangeList(0, 4)
+	other, _ := rangeList(10, 12)
+
+	err := list.Move_Range(3, 2, other, 0)
+	var range_err *common_errors.Range_Error
+	if !errors.As(err, &range_err) || range_err.Start != 3 || range_err.Count != 2 || range_err.Length != 4 {
+		t.Fatalf(`Expected *Range_Error for range past end but got %v`, err)
+	}
+	if err := list.Move_Range(5, 0, other, 0); !errors.Is(err, common_errors.ErrIndexOutOfRange) {
+		t.Fatalf(`Expected error for start past end`)
+	}
+	err = list.Move_Range(0, 1, other, 3)
+	var index_err *common_errors.Index_Error
+	if !errors.As(err, &index_err) || index_err.Index != 3 || index_err.Length != 2 {
+		t.Fatalf(`Expected *Index_Error for index past end of destination but got %v`, err)
+	}
+	err = list.Move_Range(0, 2, list, 3)
+	if !errors.As(err, &index_err) || index_err.Index != 3 || index_err.Length != 2 {
+		t.Fatalf(`Expected *Index_Error for index past end once range is removed but got %v`, err)
+	}
+	if err := list.Move_Range(0, 1, nil, 0); !errors.Is(err, ErrNilDestination) {
+		t.Fatalf(`Expected error for nil destination`)
+	}
+
+	checkInvariants(t, "errors", list, []int{0, 1, 2, 3})
+	checkInvariants(t, "errors", other, []int{10, 11})
+}
+
+func Test_Reverse_every_length(t *testing.T) {
+	for n := 0; n < 6; n++ {
+		list, nodes := rangeList(0, n)
+		list.Reverse()
+
+		expected := rangeSlice(0, n)
+		slices.Reverse(expected)
+		checkInvariants(t, fmt.Sprint("reverse ", n), list, expected)
+
+		if n > 0 && nodes[0].Cursor().Next() {
+			t.Fatalf(`Expected old head to be new tail`)
+		}
+	}
+}
+
+func Test_Rotate_every_shift(t *testing.T) {
+	for n := 0; n < 6; n++ {
+		for k := -2 * n; k <= 2*n; k++ {
+			label := fmt.Sprint("rotate ", n, " by ", k)
+			list, _ := rangeList(0, n)
+			list.Rotate(k)
+
+			expected := rangeSlice(0, n)
+			if n > 0 {
+				shift := ((k % n) + n) % n
+				expected = append(expected[n-shift:], expected[:n-shift]...)
+			}
+			checkInvariants(t, label, list, expected)
+		}
+	}
+}
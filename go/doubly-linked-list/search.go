@@ -0,0 +1,115 @@
+package doubly_linked_list
+
+import common_errors "common-errors"
+
+// Traverse list, from head to tail, and return index of first item matching
+// predicate, or -1
+func (list *List[T]) Index_Func(predicate func(T) bool) int {
+	index := 0
+	for node := list.head; node != nil; node = node.next {
+		if predicate(node.value) {
+			return index
+		}
+		index++
+	}
+	return -1
+}
+
+// Traverse list, from tail to head, and return index of last item matching
+// predicate, or -1
+func (list *List[T]) Last_Index_Func(predicate func(T) bool) int {
+	index := int(list.Length) - 1
+	for node := list.tail; node != nil; node = node.prev {
+		if predicate(node.value) {
+			return index
+		}
+		index--
+	}
+	return -1
+}
+
+// Traverse list, from head to tail, and return first item matching predicate
+func (list *List[T]) Find_Func(predicate func(T) bool) (T, error) {
+	for node := list.head; node != nil; node = node.next {
+		if predicate(node.value) {
+			return node.value, nil
+		}
+	}
+
+	var result T
+	return result, &common_errors.Not_Found_Error{Target: "Value", Container: "list"}
+}
+
+// Report whether any item matches predicate
+func (list *List[T]) Contains_Func(predicate func(T) bool) bool {
+	return list.Index_Func(predicate) >= 0
+}
+
+// Traverse list, from head to tail, and remove first item matching predicate
+func (list *List[T]) Remove_Func(predicate func(T) bool) (T, error) {
+	if list.Length == 0 {
+		var result T
+		return result, &common_errors.Empty_Error{Container: "List"}
+	}
+
+	for node := list.head; node != nil; node = node.next {
+		if predicate(node.value) {
+			return list.removeNode(node), nil
+		}
+	}
+
+	var result T
+	return result, &common_errors.Not_Found_Error{Target: "Value", Container: "list"}
+}
+
+// Remove every item matching predicate and return how many were removed
+func (list *List[T]) Remove_All_Func(predicate func(T) bool) uint {
+	removed := uint(0)
+	for node := list.head; node != nil; {
+		next := node.next
+		if predicate(node.value) {
+			list.removeNode(node)
+			removed++
+		}
+		node = next
+	}
+	return removed
+}
+
+// Keep only items matching predicate and return how many were removed
+func (list *List[T]) Retain_Func(predicate func(T) bool) uint {
+	return list.Remove_All_Func(func(item T) bool {
+		return !predicate(item)
+	})
+}
+
+// Traverse list, from head to tail, and remove first node with matching value
+func (list *Doubly_Linked_List[T]) Remove(item T) (T, error) {
+	return list.Remove_Func(equalTo(item))
+}
+
+// Returns index of first item equal to `item`, or -1
+func (list *Doubly_Linked_List[T]) Index_Of(item T) int {
+	return list.Index_Func(equalTo(item))
+}
+
+// Returns index of last item equal to `item`, or -1
+func (list *Doubly_Linked_List[T]) Last_Index_Of(item T) int {
+	return list.Last_Index_Func(equalTo(item))
+}
+
+// Report whether list holds an item equal to `item`
+func (list *Doubly_Linked_List[T]) Contains(item T) bool {
+	return list.Contains_Func(equalTo(item))
+}
+
+// Remove every item equal to `item` and return how many were removed
+func (list *Doubly_Linked_List[T]) Remove_All(item T) uint {
+	return list.Remove_All_Func(equalTo(item))
+}
+
+func equalTo[T comparable](item T) func(T) bool {
+	return func(value T) bool {
+		return value == item
+	}
+}
@@ -0,0 +1,104 @@
+package doubly_linked_list
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	common_errors "common-errors"
+)
+
+func Test_List_holds_non_comparable_items(t *testing.T) {
+	list := List[[]int]{}
+	list.Append([]int{1})
+	list.Append([]int{2, 2})
+	list.Append([]int{3, 3, 3})
+	list.Append([]int{4, 4})
+
+	hasLength := func(length int) func([]int) bool {
+		return func(item []int) bool {
+			return len(item) == length
+		}
+	}
+
+	if index := list.Index_Func(hasLength(2)); index != 1 {
+		t.Fatalf(`Expected index 1 but got %v`, index)
+	}
+	if index := list.Last_Index_Func(hasLength(2)); index != 3 {
+		t.Fatalf(`Expected last index 3 but got %v`, index)
+	}
+	if index := list.Index_Func(hasLength(9)); index != -1 {
+		t.Fatalf(`Expected index -1 but got %v`, index)
+	}
+
+	found, err := list.Find_Func(hasLength(3))
+	if err != nil || found[0] != 3 {
+		t.Fatalf(`Expected [3 3 3] but got %v, %v`, found, err)
+	}
+	if _, err := list.Find_Func(hasLength(9)); !errors.Is(err, common_errors.ErrNotFound) {
+		t.Fatalf(`Expected error when nothing matches`)
+	}
+	if !list.Contains_Func(hasLength(1)) || list.Contains_Func(hasLength(0)) {
+		t.Fatalf(`Expected Contains_Func to match only existing lengths`)
+	}
+
+	removed, err := list.Remove_Func(hasLength(2))
+	if err != nil || removed[0] != 2 || list.Length != 3 {
+		t.Fatalf(`Expected to remove [2 2] but got %v, %v`, removed, err)
+	}
+	if _, err := list.Remove_Func(hasLength(9)); !errors.Is(err, common_errors.ErrNotFound) {
+		t.Fatalf(`Expected error when nothing matches`)
+	}
+}
+
+func Test_Remove_Func_errors_on_empty_list(t *testing.T) {
+	list := List[map[string]int]{}
+
+	if _, err := list.Remove_Func(func(map[string]int) bool { return true }); !errors.Is(err, common_errors.ErrEmpty) {
+		t.Fatalf(`Expected error on empty list`)
+	}
+}
+
+func Test_Remove_All_Func_and_Retain_Func(t *testing.T) {
+	list := listOf(1, 2, 3, 4, 5, 6, 7)
+	isEven := func(item int) bool { return item%2 == 0 }
+
+	if removed := list.Remove_All_Func(isEven); removed != 3 {
+		t.Fatalf(`Expected 3 removed but got %v`, removed)
+	}
+	checkLinks(t, list, []int{1, 3, 5, 7})
+
+	if removed := list.Retain_Func(func(item int) bool { return item > 3 }); removed != 2 {
+		t.Fatalf(`Expected 2 removed but got %v`, removed)
+	}
+	checkLinks(t, list, []int{5, 7})
+
+	if removed := list.Retain_Func(isEven); removed != 2 || list.Length != 0 {
+		t.Fatalf(`Expected list emptied but got %v removed, length %v`, removed, list.Length)
+	}
+	checkLinks(t, list, []int{})
+}
+
+func Test_comparable_wrappers(t *testing.T) {
+	list := listOf(4, 1, 4, 2, 4)
+
+	if index := list.Index_Of(4); index != 0 {
+		t.Fatalf(`Expected index 0 but got %v`, index)
+	}
+	if index := list.Last_Index_Of(4); index != 4 {
+		t.Fatalf(`Expected last index 4 but got %v`, index)
+	}
+	if index := list.Index_Of(9); index != -1 {
+		t.Fatalf(`Expected index -1 but got %v`, index)
+	}
+	if !list.Contains(2) || list.Contains(3) {
+		t.Fatalf(`Expected Contains to report 2 but not 3`)
+	}
+
+	if removed := list.Remove_All(4); removed != 3 {
+		t.Fatalf(`Expected 3 removed but got %v`, removed)
+	}
+	if values := slices.Collect(list.Values()); !slices.Equal(values, []int{1, 2}) {
+		t.Fatalf(`Expected [1 2] but got %v`, values)
+	}
+}
@@ -0,0 +1,139 @@
+package doubly_linked_list
+
+import "cmp"
+
+// Stable bottom-up merge sort that relinks nodes in place, `O(n log n)` time
+// and no allocation, node handles and cursors stay valid
+//
+// `compare` returns negative when `a` sorts before `b`, as `cmp.Compare` does
+func (list *List[T]) Sort_Func(compare func(a, b T) int) {
+	if list.Length < 2 {
+		return
+	}
+
+	head := list.head
+	for width := uint(1); ; width *= 2 {
+		var tail *Node[T]
+		left := head
+		head = nil
+		merges := 0
+
+		for left != nil {
+			merges++
+
+			// Run `right` starts `width` nodes after `left`
+			right := left
+			left_size := uint(0)
+			for left_size < width && right != nil {
+				left_size++
+				right = right.next
+			}
+			right_size := width
+
+			for left_size > 0 || (right_size > 0 && right != nil) {
+				var node *Node[T]
+				if left_size == 0 {
+					node, right = right, right.next
+					right_size--
+				} else if right_size == 0 || right == nil || compare(left.value, right.value) <= 0 {
+					// Ties take from `left`, which keeps sort stable
+					node, left = left, left.next
+					left_size--
+				} else {
+					node, right = right, right.next
+					right_size--
+				}
+
+				if tail == nil {
+					head = node
+				} else {
+					tail.next = node
+				}
+				node.prev = tail
+				tail = node
+			}
+
+			left = right
+		}
+
+		tail.next = nil
+		if merges <= 1 {
+			list.head = head
+			list.tail = tail
+			return
+		}
+	}
+}
+
+// Merge nodes of already sorted `other` into already sorted list in `O(n+m)`,
+// `other` is left empty and its node handles now belong to list
+//
+// @note - stable, on ties items from list come before items from `other`
+func (list *List[T]) Merge_Func(other *List[T], compare func(a, b T) int) {
+	if other == nil || other == list || other.Length == 0 {
+		return
+	}
+
+	other.forwardTo(list)
+
+	left := list.head
+	right := other.head
+	var head, tail *Node[T]
+
+	for left != nil || right != nil {
+		var node *Node[T]
+		if right == nil || (left != nil && compare(left.value, right.value) <= 0) {
+			node, left = left, left.next
+		} else {
+			node, right = right, right.next
+		}
+
+		if tail == nil {
+			head = node
+		} else {
+			tail.next = node
+		}
+		node.prev = tail
+		tail = node
+	}
+
+	list.head = head
+	list.tail = tail
+	list.Length += other.Length
+
+	other.head = nil
+	other.tail = nil
+	other.Length = 0
+}
+
+// Report whether every item compares less than or equal to its successor
+func (list *List[T]) Is_Sorted_Func(compare func(a, b T) int) bool {
+	for node := list.head; node != nil && node.next != nil; node = node.next {
+		if compare(node.value, node.next.value) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Sort list into ascending order, see `Sort_Func`
+//
+// ## Example
+//
+//	list := Doubly_Linked_List[int]{}
+//	list.Append(3)
+//	list.Append(1)
+//	Sort(&list) // 1, 3
+func Sort[T cmp.Ordered](list *Doubly_Linked_List[T]) {
+	list.Sort_Func(cmp.Compare[T])
+}
+
+// Merge ascending `other` into ascending list, see `Merge_Func`
+func Merge[T cmp.Ordered](list, other *Doubly_Linked_List[T]) {
+	list.Merge_Func(&other.List, cmp.Compare[T])
+}
+
+// Report whether list is in ascending order
+func Is_Sorted[T cmp.Ordered](list *Doubly_Linked_List[T]) bool {
+	return list.Is_Sorted_Func(cmp.Compare[T])
+}
@@ -0,0 +1,142 @@
+package doubly_linked_list
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+type keyed struct {
+	key   int
+	order int
+}
+
+func compareKeyed(a, b keyed) int {
+	return cmp.Compare(a.key, b.key)
+}
+
+func Test_Sort_orders_lists_of_every_small_length(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+
+	for length := 0; length < 70; length++ {
+		values := make([]int, length)
+		list := &Doubly_Linked_List[int]{}
+		for i := range values {
+			values[i] = random.Intn(20)
+			list.Append(values[i])
+		}
+
+		Sort(list)
+		slices.Sort(values)
+
+		checkLinks(t, list, values)
+		if !Is_Sorted(list) {
+			t.Fatalf(`Expected sorted list of length %v`, length)
+		}
+	}
+}
+
+func Test_Sort_Func_is_stable(t *testing.T) {
+	random := rand.New(rand.NewSource(2))
+
+	items := make([]keyed, 1000)
+	list := &List[keyed]{}
+	for i := range items {
+		items[i] = keyed{key: random.Intn(10), order: i}
+		list.Append(items[i])
+	}
+
+	list.Sort_Func(compareKeyed)
+	slices.SortStableFunc(items, compareKeyed)
+
+	if sorted := slices.Collect(list.Values()); !slices.Equal(sorted, items) {
+		t.Fatalf(`Expected stable order`)
+	}
+}
+
+func Test_Sort_keeps_node_handles(t *testing.T) {
+	list := &Doubly_Linked_List[int]{}
+	list.Append(3)
+	one := list.Append(1)
+	list.Append(2)
+
+	Sort(list)
+
+	cursor := one.Cursor()
+	if !cursor.Valid() || cursor.Prev() {
+		t.Fatalf(`Expected node 1 to be valid and at head`)
+	}
+	one.Cursor().Insert_Before(0)
+	checkLinks(t, list, []int{0, 1, 2, 3})
+}
+
+func Test_Merge_interleaves_sorted_lists(t *testing.T) {
+	list := listOf(1, 3, 5, 7)
+	other := listOf(0, 3, 4, 8, 9)
+	moved := other.Cursor_Front().Node()
+
+	Merge(list, other)
+
+	checkLinks(t, list, []int{0, 1, 3, 3, 4, 5, 7, 8, 9})
+	checkLinks(t, other, []int{})
+
+	// Node handles from `other` now edit `list`
+	moved.Cursor().Insert_Before(-1)
+	checkLinks(t, list, []int{-1, 0, 1, 3, 3, 4, 5, 7, 8, 9})
+}
+
+func Test_Merge_Func_is_stable_and_handles_empty_lists(t *testing.T) {
+	list := &List[keyed]{}
+	list.Append(keyed{key: 1, order: 0})
+	list.Append(keyed{key: 2, order: 1})
+
+	other := &List[keyed]{}
+	other.Append(keyed{key: 1, order: 2})
+	other.Append(keyed{key: 2, order: 3})
+
+	list.Merge_Func(other, compareKeyed)
+	orders := []int{}
+	for _, item := range list.All() {
+		orders = append(orders, item.order)
+	}
+	if !slices.Equal(orders, []int{0, 2, 1, 3}) {
+		t.Fatalf(`Expected ties to favour receiver, got %v`, orders)
+	}
+
+	empty := &List[keyed]{}
+	empty.Merge_Func(list, compareKeyed)
+	if empty.Length != 4 || list.Length != 0 {
+		t.Fatalf(`Expected all 4 items moved but got %v and %v`, empty.Length, list.Length)
+	}
+
+	empty.Merge_Func(list, compareKeyed)
+	empty.Merge_Func(empty, compareKeyed)
+	if empty.Length != 4 {
+		t.Fatalf(`Expected merging empty list or itself to be a no-op`)
+	}
+}
+
+func Test_Is_Sorted(t *testing.T) {
+	if !Is_Sorted(listOf()) || !Is_Sorted(listOf(1)) || !Is_Sorted(listOf(1, 1, 2)) {
+		t.Fatalf(`Expected ascending lists to be sorted`)
+	}
+	if Is_Sorted(listOf(2, 1)) {
+		t.Fatalf(`Expected descending list not to be sorted`)
+	}
+}
+
+func Benchmark_Sort(b *testing.B) {
+	random := rand.New(rand.NewSource(1))
+	values := make([]int, 10000)
+	for i := range values {
+		values[i] = random.Int()
+	}
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		list := listOf(values...)
+		b.StartTimer()
+		Sort(list)
+	}
+}
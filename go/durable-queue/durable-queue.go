@@ -0,0 +1,386 @@
+package durable_queue
+
+import (
+	"fmt"
+	"os"
+
+	common_errors "common-errors"
+)
+
+// How eagerly writes and consumer checkpoints are flushed to stable storage
+type Sync_Policy uint8
+
+const (
+	// fsync every Enqueue and checkpoint every Deque, nothing is lost or
+	// delivered twice after a crash
+	Sync_Always Sync_Policy = iota
+
+	// fsync and checkpoint once every `Options.Batch_Size` operations, a crash
+	// may lose up to one batch of writes and re-deliver one batch of reads
+	Sync_Batch
+
+	// Leave flushing to the operating system, checkpoints are only written
+	// when a segment is released and on Sync/Close
+	Sync_None
+)
+
+const default_segment_size = 8 << 20
+
+const default_batch_size = 64
+
+// Tuning for `Open`, zero values fall back to sensible defaults
+type Options[T any] struct {
+	// Defaults to `JSON_Encoder`
+	Encoder Encoder[T]
+
+	// Defaults to `Sync_Always`
+	Sync_Policy Sync_Policy
+
+	// Operations between flushes under `Sync_Batch`, defaults to 64
+	Batch_Size uint
+
+	// Bytes after which a new segment file is started, defaults to 8 MiB
+	Segment_Size int64
+}
+
+// First-in first-out queue persisted as append-only segment files
+//
+// ## Example
+//
+//	queue, err := Open[string]("/var/lib/app/jobs", Options[string]{})
+//	if err != nil {
+//		return err
+//	}
+//	defer queue.Close()
+//
+//	queue.Enqueue("resize image.png")
+//	item, err := queue.Deque()
+//
+// @notes
+//
+// - Directory holds `<id>.log` segments plus a `checkpoint` of the consumer
+// position, segments are deleted once fully consumed
+// - Not safe for concurrent use, same as `queue.Queue`
+type Durable_Queue[T any] struct {
+	Length    uint
+	directory string
+	options   Options[T]
+
+	// Oldest first, reads happen in the first and writes in the last
+	segments []segment
+
+	writer      *os.File
+	reader      *os.File
+	read_offset int64
+
+	// Operations since last flush under `Sync_Batch`
+	pending uint
+}
+
+// Open, or create, queue within directory and recover state left by any
+// previous process, a torn record at end of newest segment is truncated
+func Open[T any](directory string, options Options[T]) (*Durable_Queue[T], error) {
+	if options.Encoder == nil {
+		options.Encoder = JSON_Encoder[T]{}
+	}
+	if options.Batch_Size == 0 {
+		options.Batch_Size = default_batch_size
+	}
+	if options.Segment_Size <= 0 {
+		options.Segment_Size = default_segment_size
+	}
+
+	if err := os.MkdirAll(directory, 0o755); err != nil {
+		return nil, err
+	}
+
+	checkpoint_id, checkpoint_offset, err := readCheckpoint(directory)
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := listSegments(directory)
+	if err != nil {
+		return nil, err
+	}
+
+	// Segments older than checkpoint were consumed, but not yet deleted,
+	// before the last process stopped
+	for len(ids) > 0 && ids[0] < checkpoint_id {
+		if err := os.Remove(segmentPath(directory, ids[0])); err != nil {
+			return nil, err
+		}
+		ids = ids[1:]
+	}
+
+	// Checkpoint names a segment that was already deleted, so the reader
+	// belongs at start of the next one
+	if len(ids) > 0 && ids[0] != checkpoint_id {
+		checkpoint_offset = 0
+	}
+
+	if len(ids) == 0 {
+		file, err := os.OpenFile(segmentPath(directory, checkpoint_id), os.O_CREATE|os.O_WRONLY, 0o644)
+		if err != nil {
+			return nil, err
+		}
+		file.Close()
+		ids = append(ids, checkpoint_id)
+		checkpoint_offset = 0
+	}
+
+	queue := &Durable_Queue[T]{
+		directory: directory,
+		options:   options,
+		segments:  make([]segment, len(ids)),
+	}
+
+	for i, id := range ids {
+		offset := int64(0)
+		if i == 0 {
+			offset = checkpoint_offset
+		}
+
+		is_newest := i == len(ids)-1
+		size, count, err := scanSegment(segmentPath(directory, id), offset, is_newest)
+		if err != nil {
+			return nil, err
+		}
+
+		if i == 0 && checkpoint_offset > size {
+			checkpoint_offset = size
+		}
+
+		queue.segments[i] = segment{id: id, size: size}
+		queue.Length += count
+	}
+
+	queue.read_offset = checkpoint_offset
+
+	newest := queue.segments[len(queue.segments)-1]
+	queue.writer, err = os.OpenFile(segmentPath(directory, newest.id), os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	queue.reader, err = os.Open(segmentPath(directory, queue.segments[0].id))
+	if err != nil {
+		queue.writer.Close()
+		return nil, err
+	}
+
+	if err := queue.releaseConsumed(); err != nil {
+		queue.Close()
+		return nil, err
+	}
+
+	return queue, nil
+}
+
+/**
+ * Appends item to end of queue, returns an error if item could not be encoded
+ * or written
+ *
+ * @note - under `Sync_Always` the record is flushed before it counts, so an
+ * error means the item was not enqueued, under `Sync_Batch` an error from the
+ * periodic flush leaves the item enqueued but maybe not yet durable
+ */
+func (queue *Durable_Queue[T]) Enqueue(item T) error {
+	payload, err := queue.options.Encoder.Encode(item)
+	if err != nil {
+		return fmt.Errorf("Unable to encode item: %w", err)
+	}
+
+	record := frameRecord(payload)
+
+	newest := &queue.segments[len(queue.segments)-1]
+	if newest.size > 0 && newest.size+int64(len(record)) > queue.options.Segment_Size {
+		if err := queue.rotate(); err != nil {
+			return err
+		}
+		// Reader may have drained the sealed segment already, move it onto
+		// the fresh one or it would be left reading past the end
+		if err := queue.releaseConsumed(); err != nil {
+			return err
+		}
+		newest = &queue.segments[len(queue.segments)-1]
+	}
+
+	if _, err := queue.writer.Write(record); err != nil {
+		// Drop any partial write so the next record starts on a boundary
+		queue.writer.Truncate(newest.size)
+		return err
+	}
+
+	if queue.options.Sync_Policy == Sync_Always {
+		if err := queue.writer.Sync(); err != nil {
+			// Record never counted, drop it so a reopen does not find it
+			queue.writer.Truncate(newest.size)
+			return err
+		}
+	}
+
+	newest.size += int64(len(record))
+	queue.Length++
+
+	if queue.options.Sync_Policy == Sync_Batch {
+		return queue.countPending()
+	}
+	return nil
+}
+
+/**
+ * Removes and returns first item of queue or an error
+ *
+ * @note - an item that fails to decode is still removed, so one bad record
+ * cannot wedge the queue, and the decode error is returned
+ */
+func (queue *Durable_Queue[T]) Deque() (T, error) {
+	var result T
+	if queue.Length == 0 {
+		return result, &common_errors.Empty_Error{Container: "Queue"}
+	}
+
+	payload, next, err := readRecord(queue.reader, queue.read_offset, queue.segments[0].size)
+	if err != nil {
+		return result, err
+	}
+
+	queue.read_offset = next
+	queue.Length--
+
+	result, decode_err := queue.options.Encoder.Decode(payload)
+
+	if err := queue.releaseConsumed(); err != nil {
+		return result, err
+	}
+
+	switch queue.options.Sync_Policy {
+	case Sync_Always:
+		err = writeCheckpoint(queue.directory, queue.segments[0].id, queue.read_offset, true)
+	case Sync_Batch:
+		err = queue.countPending()
+	}
+	if err != nil {
+		return result, err
+	}
+
+	if decode_err != nil {
+		return result, fmt.Errorf("Unable to decode item: %w", decode_err)
+	}
+	return result, nil
+}
+
+/**
+ * Returns first value of queue without mutation
+ */
+func (queue *Durable_Queue[T]) Peek() (T, error) {
+	var result T
+	if queue.Length == 0 {
+		return result, &common_errors.Empty_Error{Container: "Queue"}
+	}
+
+	payload, _, err := readRecord(queue.reader, queue.read_offset, queue.segments[0].size)
+	if err != nil {
+		return result, err
+	}
+
+	return queue.options.Encoder.Decode(payload)
+}
+
+/**
+ * Flush written records and consumer checkpoint to stable storage
+ */
+func (queue *Durable_Queue[T]) Sync() error {
+	queue.pending = 0
+
+	if err := queue.writer.Sync(); err != nil {
+		return err
+	}
+
+	return writeCheckpoint(queue.directory, queue.segments[0].id, queue.read_offset, true)
+}
+
+/**
+ * Sync then release file handles, queue must not be used afterwards
+ */
+func (queue *Durable_Queue[T]) Close() error {
+	err := queue.Sync()
+
+	if close_err := queue.writer.Close(); err == nil {
+		err = close_err
+	}
+	if close_err := queue.reader.Close(); err == nil {
+		err = close_err
+	}
+
+	return err
+}
+
+// Under `Sync_Batch` flush once enough operations have accumulated
+func (queue *Durable_Queue[T]) countPending() error {
+	queue.pending++
+	if queue.pending < queue.options.Batch_Size {
+		return nil
+	}
+	return queue.Sync()
+}
+
+// Seal newest segment and start appending to a fresh one
+func (queue *Durable_Queue[T]) rotate() error {
+	if queue.options.Sync_Policy != Sync_None {
+		if err := queue.writer.Sync(); err != nil {
+			return err
+		}
+	}
+
+	id := queue.segments[len(queue.segments)-1].id + 1
+	writer, err := os.OpenFile(segmentPath(queue.directory, id), os.O_CREATE|os.O_EXCL|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+
+	if queue.options.Sync_Policy == Sync_Always {
+		if err := syncDirectory(queue.directory); err != nil {
+			writer.Close()
+			return err
+		}
+	}
+
+	queue.writer.Close()
+	queue.writer = writer
+	queue.segments = append(queue.segments, segment{id: id})
+	return nil
+}
+
+// Delete oldest segments once reader has passed their final record
+//
+// @note - checkpoint is moved before a file is removed, though recovery also
+// copes with the reverse since a missing segment implies it was consumed
+func (queue *Durable_Queue[T]) releaseConsumed() error {
+	for len(queue.segments) > 1 && queue.read_offset >= queue.segments[0].size {
+		consumed := queue.segments[0]
+		next := queue.segments[1]
+
+		durable := queue.options.Sync_Policy != Sync_None
+		if err := writeCheckpoint(queue.directory, next.id, 0, durable); err != nil {
+			return err
+		}
+
+		reader, err := os.Open(segmentPath(queue.directory, next.id))
+		if err != nil {
+			return err
+		}
+
+		queue.reader.Close()
+		queue.reader = reader
+		queue.read_offset = 0
+		queue.segments = queue.segments[1:]
+
+		if err := os.Remove(segmentPath(queue.directory, consumed.id)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,300 @@
+package durable_queue
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"testing"
+
+	common_errors "common-errors"
+)
+
+func Test_Enqueue_increments_length(t *testing.T) {
+	queue, err := Open[uint](t.TempDir(), Options[uint]{})
+	if err != nil {
+		t.Fatalf(`Unexpected error %v`, err)
+	}
+	defer queue.Close()
+
+	limit := uint(3)
+
+	for i := uint(0); i < limit; i++ {
+		if err := queue.Enqueue(i); err != nil {
+			t.Fatalf(`Unexpected error %v`, err)
+		}
+	}
+
+	if queue.Length != limit {
+		t.Fatalf(`Expected queue.Length of %v but got %v`, limit, queue.Length)
+	}
+}
+
+func Test_Deque_and_Peek_return_error_for_empty_queue(t *testing.T) {
+	queue, err := Open[uint](t.TempDir(), Options[uint]{})
+	if err != nil {
+		t.Fatalf(`Unexpected error %v`, err)
+	}
+	defer queue.Close()
+
+	if _, err := queue.Deque(); !errors.Is(err, common_errors.ErrEmpty) {
+		t.Fatalf(`Expected ErrEmpty but got %v`, err)
+	}
+
+	if _, err := queue.Peek(); !errors.Is(err, common_errors.ErrEmpty) {
+		t.Fatalf(`Expected ErrEmpty but got %v`, err)
+	}
+}
+
+func Test_Deque_decrements_length_and_returns_values(t *testing.T) {
+	queue, err := Open[uint](t.TempDir(), Options[uint]{})
+	if err != nil {
+		t.Fatalf(`Unexpected error %v`, err)
+	}
+	defer queue.Close()
+
+	limit := uint(3)
+	expected_length := limit
+
+	for i := uint(0); i < limit; i++ {
+		queue.Enqueue(i)
+	}
+
+	for i := uint(0); i < limit; i++ {
+		peek, err := queue.Peek()
+		if err != nil {
+			t.Fatalf(`Unexpected error %v`, err)
+		}
+
+		value, err := queue.Deque()
+		if err != nil {
+			t.Fatalf(`Unexpected error %v`, err)
+		}
+
+		if value != i || peek != i {
+			t.Fatalf(`Expected queue value of %v but got %v and peeked %v`, i, value, peek)
+		}
+
+		expected_length--
+		if expected_length != queue.Length {
+			t.Fatalf(`Expected queue length of %v but got %v`, expected_length, queue.Length)
+		}
+	}
+}
+
+func Test_Open_recovers_unconsumed_items_after_restart(t *testing.T) {
+	for _, policy := range []Sync_Policy{Sync_Always, Sync_Batch, Sync_None} {
+		directory := t.TempDir()
+		options := Options[string]{Sync_Policy: policy, Segment_Size: 64}
+
+		queue, err := Open[string](directory, options)
+		if err != nil {
+			t.Fatalf(`Unexpected error %v`, err)
+		}
+
+		limit := 20
+		for i := 0; i < limit; i++ {
+			queue.Enqueue(strconv.Itoa(i))
+		}
+
+		consumed := 7
+		for i := 0; i < consumed; i++ {
+			queue.Deque()
+		}
+
+		if err := queue.Close(); err != nil {
+			t.Fatalf(`Unexpected error %v`, err)
+		}
+
+		queue, err = Open[string](directory, options)
+		if err != nil {
+			t.Fatalf(`Unexpected error %v`, err)
+		}
+
+		if queue.Length != uint(limit-consumed) {
+			t.Fatalf(`Policy %v expected length %v after reopen but got %v`, policy, limit-consumed, queue.Length)
+		}
+
+		for i := consumed; i < limit; i++ {
+			value, err := queue.Deque()
+			if err != nil {
+				t.Fatalf(`Unexpected error %v`, err)
+			}
+			if value != strconv.Itoa(i) {
+				t.Fatalf(`Policy %v expected value %v but got %v`, policy, i, value)
+			}
+		}
+
+		queue.Close()
+	}
+}
+
+func Test_Segments_rotate_and_are_deleted_once_consumed(t *testing.T) {
+	directory := t.TempDir()
+
+	queue, err := Open[int](directory, Options[int]{Segment_Size: 32})
+	if err != nil {
+		t.Fatalf(`Unexpected error %v`, err)
+	}
+	defer queue.Close()
+
+	limit := 30
+	for i := 0; i < limit; i++ {
+		queue.Enqueue(i)
+	}
+
+	ids, _ := listSegments(directory)
+	if len(ids) < 3 {
+		t.Fatalf(`Expected several segments after rotation but got %v`, len(ids))
+	}
+
+	for i := 0; i < limit; i++ {
+		value, err := queue.Deque()
+		if err != nil {
+			t.Fatalf(`Unexpected error %v`, err)
+		}
+		if value != i {
+			t.Fatalf(`Expected value %v but got %v`, i, value)
+		}
+	}
+
+	ids, _ = listSegments(directory)
+	if len(ids) != 1 {
+		t.Fatalf(`Expected only the write segment to remain but got %v`, ids)
+	}
+}
+
+func Test_Enqueue_after_full_drain_rotates_reader_along(t *testing.T) {
+	directory := t.TempDir()
+
+	queue, err := Open[string](directory, Options[string]{Segment_Size: 32})
+	if err != nil {
+		t.Fatalf(`Unexpected error %v`, err)
+	}
+	defer queue.Close()
+
+	first := "abcdefghijklmnop"
+	second := "abcdefghijklmnopqr"
+
+	queue.Enqueue(first)
+	if value, err := queue.Deque(); err != nil || value != first {
+		t.Fatalf(`Expected %q but got %q, %v`, first, value, err)
+	}
+
+	// Drained segment is sealed by this rotation
+	queue.Enqueue(second)
+	if value, err := queue.Peek(); err != nil || value != second {
+		t.Fatalf(`Expected to peek %q but got %q, %v`, second, value, err)
+	}
+	if value, err := queue.Deque(); err != nil || value != second {
+		t.Fatalf(`Expected %q but got %q, %v`, second, value, err)
+	}
+	if queue.Length != 0 {
+		t.Fatalf(`Expected queue.Length of 0 but got %v`, queue.Length)
+	}
+
+	ids, _ := listSegments(directory)
+	if len(ids) != 1 {
+		t.Fatalf(`Expected drained segment deleted but got %v`, ids)
+	}
+}
+
+func Test_Open_truncates_torn_final_record(t *testing.T) {
+	directory := t.TempDir()
+
+	queue, err := Open[string](directory, Options[string]{})
+	if err != nil {
+		t.Fatalf(`Unexpected error %v`, err)
+	}
+	queue.Enqueue("first")
+	queue.Enqueue("second")
+	queue.Close()
+
+	// Simulate crash part way through writing a third record
+	ids, _ := listSegments(directory)
+	path := segmentPath(directory, ids[len(ids)-1])
+	file, _ := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+	torn := frameRecord([]byte(`"third"`))
+	file.Write(torn[:len(torn)-3])
+	file.Close()
+
+	before, _ := os.Stat(path)
+
+	queue, err = Open[string](directory, Options[string]{})
+	if err != nil {
+		t.Fatalf(`Unexpected error %v`, err)
+	}
+	defer queue.Close()
+
+	if queue.Length != 2 {
+		t.Fatalf(`Expected torn record to be dropped, length 2 but got %v`, queue.Length)
+	}
+
+	after, _ := os.Stat(path)
+	if after.Size() != before.Size()-int64(len(torn)-3) {
+		t.Fatalf(`Expected segment truncated to %v bytes but got %v`, before.Size()-int64(len(torn)-3), after.Size())
+	}
+
+	queue.Enqueue("third")
+	for _, expected := range []string{"first", "second", "third"} {
+		value, err := queue.Deque()
+		if err != nil {
+			t.Fatalf(`Unexpected error %v`, err)
+		}
+		if value != expected {
+			t.Fatalf(`Expected value %v but got %v`, expected, value)
+		}
+	}
+}
+
+func Test_Open_errors_on_corrupt_sealed_segment(t *testing.T) {
+	directory := t.TempDir()
+
+	queue, _ := Open[int](directory, Options[int]{Segment_Size: 16})
+	for i := 0; i < 10; i++ {
+		queue.Enqueue(i)
+	}
+	queue.Close()
+
+	ids, _ := listSegments(directory)
+	file, _ := os.OpenFile(segmentPath(directory, ids[0]), os.O_WRONLY, 0)
+	file.WriteAt([]byte{0xff, 0xff}, record_header_size)
+	file.Close()
+
+	if _, err := Open[int](directory, Options[int]{Segment_Size: 16}); !errors.Is(err, ErrCorrupt) {
+		t.Fatalf(`Expected ErrCorrupt but got %v`, err)
+	}
+}
+
+type failing_encoder struct {
+	JSON_Encoder[int]
+}
+
+func (failing_encoder) Decode(data []byte) (int, error) {
+	if string(data) == "13" {
+		return 0, errors.New("unlucky")
+	}
+	return JSON_Encoder[int]{}.Decode(data)
+}
+
+func Test_Deque_skips_item_that_fails_to_decode(t *testing.T) {
+	queue, err := Open[int](t.TempDir(), Options[int]{Encoder: failing_encoder{}})
+	if err != nil {
+		t.Fatalf(`Unexpected error %v`, err)
+	}
+	defer queue.Close()
+
+	queue.Enqueue(13)
+	queue.Enqueue(14)
+
+	if _, err := queue.Deque(); err == nil {
+		t.Fatalf(`Expected decode error not nil -> %v`, err)
+	}
+
+	value, err := queue.Deque()
+	if err != nil {
+		t.Fatalf(`Unexpected error %v`, err)
+	}
+	if value != 14 {
+		t.Fatalf(`Expected value 14 but got %v`, value)
+	}
+}
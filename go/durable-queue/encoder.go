@@ -0,0 +1,22 @@
+package durable_queue
+
+import "encoding/json"
+
+// Converts queued values to and from the bytes written in segment records
+type Encoder[T any] interface {
+	Encode(item T) ([]byte, error)
+	Decode(data []byte) (T, error)
+}
+
+// Default encoder, stores each value as one JSON document
+type JSON_Encoder[T any] struct{}
+
+func (JSON_Encoder[T]) Encode(item T) ([]byte, error) {
+	return json.Marshal(item)
+}
+
+func (JSON_Encoder[T]) Decode(data []byte) (T, error) {
+	var result T
+	err := json.Unmarshal(data, &result)
+	return result, err
+}
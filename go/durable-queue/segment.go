@@ -0,0 +1,226 @@
+package durable_queue
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Each record is `length | crc32 | payload`, both header fields little endian
+const record_header_size = 8
+
+const segment_extension = ".log"
+
+const checkpoint_name = "checkpoint"
+
+// Wrapped by errors from `Open` when a sealed segment or the checkpoint file
+// fails its checksum, match with `errors.Is`
+var ErrCorrupt = errors.New("Corrupt queue data")
+
+// Returned by `readRecord` when bytes at offset are not a whole valid record
+var errTornRecord = errors.New("Torn or corrupt record")
+
+// Append-only log file, `size` is offset one past the last valid record
+type segment struct {
+	id   uint64
+	size int64
+}
+
+func segmentPath(directory string, id uint64) string {
+	return filepath.Join(directory, fmt.Sprintf("%020d%s", id, segment_extension))
+}
+
+// Returns ids of all segment files within directory, oldest first
+func listSegments(directory string) ([]uint64, error) {
+	entries, err := os.ReadDir(directory)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint64, 0)
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, segment_extension) {
+			continue
+		}
+
+		id, err := strconv.ParseUint(strings.TrimSuffix(name, segment_extension), 10, 64)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids, nil
+}
+
+func frameRecord(payload []byte) []byte {
+	record := make([]byte, record_header_size+len(payload))
+	binary.LittleEndian.PutUint32(record[0:4], uint32(len(payload)))
+	binary.LittleEndian.PutUint32(record[4:8], crc32.ChecksumIEEE(payload))
+	copy(record[record_header_size:], payload)
+	return record
+}
+
+// Read record at offset without reading past `limit`
+//
+// Returns `io.EOF` when offset equals limit, or `errTornRecord` when the bytes
+// before limit do not hold a complete record with matching checksum
+func readRecord(file *os.File, offset, limit int64) ([]byte, int64, error) {
+	if offset >= limit {
+		return nil, offset, io.EOF
+	}
+	if limit-offset < record_header_size {
+		return nil, offset, errTornRecord
+	}
+
+	header := make([]byte, record_header_size)
+	if _, err := file.ReadAt(header, offset); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, offset, errTornRecord
+		}
+		return nil, offset, err
+	}
+
+	length := int64(binary.LittleEndian.Uint32(header[0:4]))
+	checksum := binary.LittleEndian.Uint32(header[4:8])
+
+	// Checked before allocating, so a garbage length cannot exhaust memory
+	if length > limit-offset-record_header_size {
+		return nil, offset, errTornRecord
+	}
+
+	payload := make([]byte, length)
+	if _, err := file.ReadAt(payload, offset+record_header_size); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, offset, errTornRecord
+		}
+		return nil, offset, err
+	}
+
+	if crc32.ChecksumIEEE(payload) != checksum {
+		return nil, offset, errTornRecord
+	}
+
+	return payload, offset + record_header_size + length, nil
+}
+
+// Count whole records between offset and end of file
+//
+// When `truncate` is true a torn final record is cut off, which is how a crash
+// mid-write of the newest segment is recovered, otherwise it is an error
+func scanSegment(path string, offset int64, truncate bool) (size int64, count uint, err error) {
+	file, err := os.OpenFile(path, os.O_RDWR, 0)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return 0, 0, err
+	}
+	limit := info.Size()
+
+	if offset > limit {
+		offset = limit
+	}
+
+	for {
+		_, next, err := readRecord(file, offset, limit)
+		if errors.Is(err, io.EOF) {
+			return offset, count, nil
+		} else if errors.Is(err, errTornRecord) {
+			if !truncate {
+				return 0, 0, fmt.Errorf("%w: record in %s at offset %d", ErrCorrupt, path, offset)
+			}
+			if err := file.Truncate(offset); err != nil {
+				return 0, 0, err
+			}
+			return offset, count, file.Sync()
+		} else if err != nil {
+			return 0, 0, err
+		}
+
+		offset = next
+		count++
+	}
+}
+
+// Read consumer position, missing file means nothing has been consumed yet
+func readCheckpoint(directory string) (id uint64, offset int64, err error) {
+	data, err := os.ReadFile(filepath.Join(directory, checkpoint_name))
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, 0, nil
+	} else if err != nil {
+		return 0, 0, err
+	}
+
+	if len(data) != 20 || crc32.ChecksumIEEE(data[:16]) != binary.LittleEndian.Uint32(data[16:20]) {
+		return 0, 0, fmt.Errorf("%w: checkpoint file", ErrCorrupt)
+	}
+
+	id = binary.LittleEndian.Uint64(data[0:8])
+	offset = int64(binary.LittleEndian.Uint64(data[8:16]))
+	return id, offset, nil
+}
+
+// Atomically replace consumer position by writing a temporary file and
+// renaming it over the old one
+func writeCheckpoint(directory string, id uint64, offset int64, durable bool) error {
+	data := make([]byte, 20)
+	binary.LittleEndian.PutUint64(data[0:8], id)
+	binary.LittleEndian.PutUint64(data[8:16], uint64(offset))
+	binary.LittleEndian.PutUint32(data[16:20], crc32.ChecksumIEEE(data[:16]))
+
+	path := filepath.Join(directory, checkpoint_name)
+	temporary := path + ".tmp"
+
+	file, err := os.OpenFile(temporary, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return err
+	}
+
+	if durable {
+		if err := file.Sync(); err != nil {
+			file.Close()
+			return err
+		}
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(temporary, path); err != nil {
+		return err
+	}
+
+	if durable {
+		return syncDirectory(directory)
+	}
+	return nil
+}
+
+// Persist creation, rename and removal of directory entries
+func syncDirectory(directory string) error {
+	handle, err := os.Open(directory)
+	if err != nil {
+		return err
+	}
+	defer handle.Close()
+	return handle.Sync()
+}
@@ -0,0 +1,304 @@
+package expression
+
+import (
+	"errors"
+	"math"
+	"queue"
+	"stack"
+)
+
+// Callable from expressions, `Arity` of -1 accepts any number of arguments
+type Function struct {
+	Arity int
+	Call  func(arguments []any) (any, error)
+}
+
+// Names visible to an expression, `Functions` are consulted before the
+// defaults so callers may override built-ins
+type Environment struct {
+	Variables map[string]any
+	Functions map[string]Function
+}
+
+func numericFunction(call func(float64) float64) Function {
+	return Function{
+		Arity: 1,
+		Call: func(arguments []any) (any, error) {
+			number, ok := arguments[0].(float64)
+			if !ok {
+				return nil, errors.New("Expected a number")
+			}
+			return call(number), nil
+		},
+	}
+}
+
+func foldFunction(choose func(float64, float64) float64) Function {
+	return Function{
+		Arity: -1,
+		Call: func(arguments []any) (any, error) {
+			if len(arguments) == 0 {
+				return nil, errors.New("Expected at least one argument")
+			}
+
+			result, ok := arguments[0].(float64)
+			if !ok {
+				return nil, errors.New("Expected a number")
+			}
+
+			for _, argument := range arguments[1:] {
+				number, ok := argument.(float64)
+				if !ok {
+					return nil, errors.New("Expected a number")
+				}
+				result = choose(result, number)
+			}
+			return result, nil
+		},
+	}
+}
+
+// Built-in functions available to every expression
+var default_functions = map[string]Function{
+	"abs":   numericFunction(math.Abs),
+	"ceil":  numericFunction(math.Ceil),
+	"floor": numericFunction(math.Floor),
+	"sqrt":  numericFunction(math.Sqrt),
+	"min":   foldFunction(math.Min),
+	"max":   foldFunction(math.Max),
+	"pow": {
+		Arity: 2,
+		Call: func(arguments []any) (any, error) {
+			base, base_ok := arguments[0].(float64)
+			exponent, exponent_ok := arguments[1].(float64)
+			if !base_ok || !exponent_ok {
+				return nil, errors.New("Expected numbers")
+			}
+			return math.Pow(base, exponent), nil
+		},
+	},
+}
+
+/**
+ * Tokenize, parse and evaluate infix expression, result is `float64` or `bool`
+ *
+ * ## Example
+ *
+ *	result, err := Evaluate("max(a, 2) * -3 >= -9", Environment{
+ *		Variables: map[string]any{"a": 3},
+ *	})
+ *	// result == true
+ */
+func Evaluate(input string, environment Environment) (any, error) {
+	tokens, err := Tokenize(input)
+	if err != nil {
+		return nil, err
+	}
+
+	rpn, err := To_RPN(tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	return Evaluate_RPN(rpn, environment)
+}
+
+/**
+ * Drain reverse Polish notation queue, produced by `To_RPN`, through a value
+ * stack and return the single value left over
+ */
+func Evaluate_RPN(rpn *queue.Queue[Token], environment Environment) (any, error) {
+	values := stack.Stack[any]{}
+
+	for rpn.Length > 0 {
+		token, _ := rpn.Deque()
+
+		switch token.Kind {
+		case Token_Number, Token_Boolean:
+			values.Push(token.Value)
+
+		case Token_Variable:
+			value, ok := environment.Variables[token.Text]
+			if !ok {
+				return nil, columnError(token.Column, "Unknown variable %q", token.Text)
+			}
+			normalized, ok := normalize(value)
+			if !ok {
+				return nil, columnError(token.Column, "Variable %q holds unsupported type %T", token.Text, value)
+			}
+			values.Push(normalized)
+
+		case Token_Unary_Operator:
+			operand, err := values.Pop()
+			if err != nil {
+				return nil, columnError(token.Column, "Missing operand for %q", token.Text)
+			}
+			result, err := applyUnary(token.Text, operand)
+			if err != nil {
+				return nil, columnError(token.Column, "%s", err)
+			}
+			values.Push(result)
+
+		case Token_Operator:
+			right, right_err := values.Pop()
+			left, left_err := values.Pop()
+			if right_err != nil || left_err != nil {
+				return nil, columnError(token.Column, "Missing operand for %q", token.Text)
+			}
+			result, err := applyBinary(token.Text, left, right)
+			if err != nil {
+				return nil, columnError(token.Column, "%s", err)
+			}
+			values.Push(result)
+
+		case Token_Function:
+			function, ok := environment.Functions[token.Text]
+			if !ok {
+				function, ok = default_functions[token.Text]
+			}
+			if !ok {
+				return nil, columnError(token.Column, "Unknown function %q", token.Text)
+			}
+			if function.Arity >= 0 && function.Arity != token.Arity {
+				return nil, columnError(token.Column, "Function %q expects %d arguments but got %d", token.Text, function.Arity, token.Arity)
+			}
+
+			arguments := make([]any, token.Arity)
+			for i := token.Arity - 1; i >= 0; i-- {
+				argument, err := values.Pop()
+				if err != nil {
+					return nil, columnError(token.Column, "Missing argument for %q", token.Text)
+				}
+				arguments[i] = argument
+			}
+
+			result, err := function.Call(arguments)
+			if err != nil {
+				return nil, columnError(token.Column, "%s: %s", token.Text, err)
+			}
+			normalized, ok := normalize(result)
+			if !ok {
+				return nil, columnError(token.Column, "Function %q returned unsupported type %T", token.Text, result)
+			}
+			values.Push(normalized)
+		}
+	}
+
+	if values.Length != 1 {
+		return nil, columnError(1, "Expression did not reduce to a single value")
+	}
+
+	return values.Pop()
+}
+
+// Widen every numeric type to `float64`, only numbers and booleans are allowed
+func normalize(value any) (any, bool) {
+	switch value := value.(type) {
+	case bool:
+		return value, true
+	case float64:
+		return value, true
+	case float32:
+		return float64(value), true
+	case int:
+		return float64(value), true
+	case int8:
+		return float64(value), true
+	case int16:
+		return float64(value), true
+	case int32:
+		return float64(value), true
+	case int64:
+		return float64(value), true
+	case uint:
+		return float64(value), true
+	case uint8:
+		return float64(value), true
+	case uint16:
+		return float64(value), true
+	case uint32:
+		return float64(value), true
+	case uint64:
+		return float64(value), true
+	}
+	return nil, false
+}
+
+func applyUnary(text string, operand any) (any, error) {
+	switch text {
+	case "-":
+		if number, ok := operand.(float64); ok {
+			return -number, nil
+		}
+		return nil, errors.New("Operator \"-\" expects a number")
+	case "!":
+		if boolean, ok := operand.(bool); ok {
+			return !boolean, nil
+		}
+		return nil, errors.New("Operator \"!\" expects a boolean")
+	}
+	return nil, errors.New("Unknown operator")
+}
+
+func applyBinary(text string, left, right any) (any, error) {
+	switch text {
+	case "==", "!=":
+		// Values are normalized to booleans or numbers, a mix of the two is
+		// a mistake rather than plain inequality
+		_, left_boolean := left.(bool)
+		_, right_boolean := right.(bool)
+		if left_boolean != right_boolean {
+			return nil, errors.New("Operator \"" + text + "\" expects operands of the same type")
+		}
+		if text == "==" {
+			return left == right, nil
+		}
+		return left != right, nil
+	case "&&", "||":
+		left_boolean, left_ok := left.(bool)
+		right_boolean, right_ok := right.(bool)
+		if !left_ok || !right_ok {
+			return nil, errors.New("Operator \"" + text + "\" expects booleans")
+		}
+		if text == "&&" {
+			return left_boolean && right_boolean, nil
+		}
+		return left_boolean || right_boolean, nil
+	}
+
+	a, left_ok := left.(float64)
+	b, right_ok := right.(float64)
+	if !left_ok || !right_ok {
+		return nil, errors.New("Operator \"" + text + "\" expects numbers")
+	}
+
+	switch text {
+	case "+":
+		return a + b, nil
+	case "-":
+		return a - b, nil
+	case "*":
+		return a * b, nil
+	case "/":
+		if b == 0 {
+			return nil, errors.New("Division by zero")
+		}
+		return a / b, nil
+	case "%":
+		if b == 0 {
+			return nil, errors.New("Division by zero")
+		}
+		return math.Mod(a, b), nil
+	case "^":
+		return math.Pow(a, b), nil
+	case "<":
+		return a < b, nil
+	case "<=":
+		return a <= b, nil
+	case ">":
+		return a > b, nil
+	case ">=":
+		return a >= b, nil
+	}
+	return nil, errors.New("Unknown operator")
+}
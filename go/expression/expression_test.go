@@ -0,0 +1,137 @@
+package expression
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func rpnText(t *testing.T, input string) string {
+	tokens, err := Tokenize(input)
+	if err != nil {
+		t.Fatalf(`Unexpected error %v`, err)
+	}
+
+	rpn, err := To_RPN(tokens)
+	if err != nil {
+		t.Fatalf(`Unexpected error %v`, err)
+	}
+
+	texts := make([]string, 0, rpn.Length)
+	for rpn.Length > 0 {
+		token, _ := rpn.Deque()
+		if token.Kind == Token_Unary_Operator {
+			texts = append(texts, "u"+token.Text)
+		} else {
+			texts = append(texts, token.Text)
+		}
+	}
+	return strings.Join(texts, " ")
+}
+
+func Test_To_RPN_respects_precedence_and_associativity(t *testing.T) {
+	cases := map[string]string{
+		"1 + 2 * 3":         "1 2 3 * +",
+		"(1 + 2) * 3":       "1 2 + 3 *",
+		"8 - 4 - 2":         "8 4 - 2 -",
+		"2 ^ 3 ^ 2":         "2 3 2 ^ ^",
+		"-2 ^ 2":            "2 2 ^ u-",
+		"-a * b":            "a u- b *",
+		"a < b && !c || d":  "a b < c u! && d ||",
+		"max(1, 2 + 3, x)":  "1 2 3 + x max",
+		"f() + g(h(1))":     "f 1 h g +",
+		"1 - -1":            "1 1 u- -",
+		"a == b != (c > d)": "a b == c d > !=",
+	}
+
+	for input, expected := range cases {
+		if result := rpnText(t, input); result != expected {
+			t.Fatalf(`Input %q expected RPN %q but got %q`, input, expected, result)
+		}
+	}
+}
+
+func Test_Evaluate_returns_expected_values(t *testing.T) {
+	environment := Environment{
+		Variables: map[string]any{
+			"a":    3,
+			"b":    4.5,
+			"flag": true,
+		},
+		Functions: map[string]Function{
+			"double": {
+				Arity: 1,
+				Call: func(arguments []any) (any, error) {
+					return arguments[0].(float64) * 2, nil
+				},
+			},
+		},
+	}
+
+	cases := map[string]any{
+		"1 + 2 * 3":                  7.0,
+		"(1 + 2) * 3":                9.0,
+		"-2 ^ 2":                     -4.0,
+		"2 ^ 3 ^ 2":                  512.0,
+		"10 % 4":                     2.0,
+		"1.5e1 / 3":                  5.0,
+		"a * b":                      13.5,
+		"double(a) - 1":              5.0,
+		"max(1, a, 2) + min(b, 9)":   7.5,
+		"pow(2, 10)":                 1024.0,
+		"abs(-3) == 3":               true,
+		"a < b && !flag":             false,
+		"a >= 3 || false":            true,
+		"flag != (a == 3)":           false,
+		"sqrt(16) + floor(1.9) * -1": 3.0,
+	}
+
+	for input, expected := range cases {
+		result, err := Evaluate(input, environment)
+		if err != nil {
+			t.Fatalf(`Input %q unexpected error %v`, input, err)
+		}
+		if result != expected {
+			t.Fatalf(`Input %q expected %v but got %v`, input, expected, result)
+		}
+	}
+}
+
+func Test_Evaluate_reports_column_of_bad_token(t *testing.T) {
+	environment := Environment{
+		Variables: map[string]any{"x": 1, "yes": true},
+	}
+
+	cases := map[string]int{
+		"1 + $":       5,
+		"1 + * 2":     5,
+		"(1 + 2":      1,
+		"1 + 2)":      6,
+		"1 2":         3,
+		"1 +":         4,
+		"max(1,,2)":   7,
+		"1, 2":        2,
+		"x + y":       5,
+		"unknown(1)":  1,
+		"pow(1)":      1,
+		"1 / (x - 1)": 3,
+		"yes + 1":     5,
+		"-yes":        1,
+		"2 (3)":       3,
+		"max(1, )":    8,
+		"yes == 1":    5,
+		"x != true":   3,
+	}
+
+	for input, column := range cases {
+		_, err := Evaluate(input, environment)
+
+		var column_err *Error
+		if !errors.As(err, &column_err) {
+			t.Fatalf(`Input %q expected *Error but got %v`, input, err)
+		}
+		if column_err.Column != column {
+			t.Fatalf(`Input %q expected column %v but got %v (%v)`, input, column, column_err.Column, err)
+		}
+	}
+}
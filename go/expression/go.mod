@@ -0,0 +1,16 @@
+module expression
+
+go 1.23
+
+require (
+	queue v0.0.0
+	stack v0.0.0
+)
+
+require common-errors v0.0.0 // indirect
+
+replace (
+	common-errors => ../common-errors
+	queue => ../queue
+	stack => ../stack
+)
@@ -0,0 +1,205 @@
+package expression
+
+import (
+	"queue"
+	"stack"
+)
+
+type operator struct {
+	precedence int
+	right      bool
+}
+
+var binary_operators = map[string]operator{
+	"||": {precedence: 1},
+	"&&": {precedence: 2},
+	"==": {precedence: 3},
+	"!=": {precedence: 3},
+	"<":  {precedence: 4},
+	"<=": {precedence: 4},
+	">":  {precedence: 4},
+	">=": {precedence: 4},
+	"+":  {precedence: 5},
+	"-":  {precedence: 5},
+	"*":  {precedence: 6},
+	"/":  {precedence: 6},
+	"%":  {precedence: 6},
+	"^":  {precedence: 8, right: true},
+}
+
+// Binds looser than `^` so `-2^2` is `-(2^2)`, same as mathematics
+var unary_operators = map[string]operator{
+	"-": {precedence: 7, right: true},
+	"!": {precedence: 7, right: true},
+}
+
+func precedenceOf(token Token) operator {
+	if token.Kind == Token_Unary_Operator {
+		return unary_operators[token.Text]
+	}
+	return binary_operators[token.Text]
+}
+
+// Bookkeeping for one open parenthesis, counts arguments of function calls
+type paren_frame struct {
+	function     bool
+	count        int
+	has_argument bool
+}
+
+/**
+ * Convert infix tokens to reverse Polish notation with the shunting-yard
+ * algorithm, operators wait on a `stack.Stack` and output collects in a
+ * `queue.Queue` ready for `Evaluate_RPN`
+ *
+ * Errors are `*Error` carrying the column of the token that broke the grammar
+ *
+ * ## Example
+ *
+ *	tokens, _ := Tokenize("1 + 2 * 3")
+ *	rpn, _ := To_RPN(tokens) // 1 2 3 * +
+ */
+func To_RPN(tokens []Token) (*queue.Queue[Token], error) {
+	output := &queue.Queue[Token]{}
+	operators := stack.Stack[Token]{}
+	parens := stack.Stack[*paren_frame]{}
+
+	// Alternates between wanting a value and wanting an operator, which is
+	// how unary minus is told apart from subtraction
+	expect_operand := true
+
+	markArgument := func() {
+		if frame, err := parens.Peek(); err == nil {
+			frame.has_argument = true
+		}
+	}
+
+	for i, token := range tokens {
+		switch token.Kind {
+		case Token_Number, Token_Boolean, Token_Variable:
+			if !expect_operand {
+				return nil, columnError(token.Column, "Unexpected %q, expected an operator", token.Text)
+			}
+			markArgument()
+			output.Enqueue(token)
+			expect_operand = false
+
+		case Token_Function:
+			if !expect_operand {
+				return nil, columnError(token.Column, "Unexpected %q, expected an operator", token.Text)
+			}
+			markArgument()
+			operators.Push(token)
+
+		case Token_Left_Paren:
+			if !expect_operand {
+				return nil, columnError(token.Column, "Unexpected \"(\", expected an operator")
+			}
+
+			is_call := i > 0 && tokens[i-1].Kind == Token_Function
+			if !is_call {
+				markArgument()
+			}
+			operators.Push(token)
+			parens.Push(&paren_frame{function: is_call})
+
+		case Token_Comma:
+			frame, err := parens.Peek()
+			if err != nil || !frame.function {
+				return nil, columnError(token.Column, "Unexpected \",\" outside of function call")
+			}
+			if expect_operand {
+				return nil, columnError(token.Column, "Missing argument before \",\"")
+			}
+			popUntilParen(&operators, output)
+			frame.count++
+			frame.has_argument = false
+			expect_operand = true
+
+		case Token_Right_Paren:
+			frame, err := parens.Pop()
+			if err != nil {
+				return nil, columnError(token.Column, "Unmatched \")\"")
+			}
+
+			is_empty_call := frame.function && frame.count == 0 && !frame.has_argument
+			if expect_operand && !is_empty_call {
+				return nil, columnError(token.Column, "Expected operand before \")\"")
+			}
+
+			popUntilParen(&operators, output)
+			operators.Pop()
+
+			if frame.function {
+				function, _ := operators.Pop()
+				function.Arity = frame.count
+				if frame.has_argument {
+					function.Arity++
+				}
+				output.Enqueue(function)
+			}
+			expect_operand = false
+
+		case Token_Operator:
+			if expect_operand {
+				if _, ok := unary_operators[token.Text]; !ok {
+					return nil, columnError(token.Column, "Expected operand before %q", token.Text)
+				}
+				token.Kind = Token_Unary_Operator
+				markArgument()
+				operators.Push(token)
+				continue
+			}
+
+			current := precedenceOf(token)
+			for {
+				top, err := operators.Peek()
+				if err != nil || (top.Kind != Token_Operator && top.Kind != Token_Unary_Operator) {
+					break
+				}
+
+				pending := precedenceOf(top)
+				if pending.precedence < current.precedence || (pending.precedence == current.precedence && current.right) {
+					break
+				}
+
+				operators.Pop()
+				output.Enqueue(top)
+			}
+
+			operators.Push(token)
+			expect_operand = true
+		}
+	}
+
+	if expect_operand {
+		column := 1
+		if len(tokens) > 0 {
+			last := tokens[len(tokens)-1]
+			column = last.Column + len([]rune(last.Text))
+		}
+		return nil, columnError(column, "Unexpected end of expression")
+	}
+
+	for operators.Length > 0 {
+		top, _ := operators.Pop()
+		if top.Kind == Token_Left_Paren {
+			return nil, columnError(top.Column, "Unmatched \"(\"")
+		}
+		output.Enqueue(top)
+	}
+
+	return output, nil
+}
+
+// Move operators to output until the innermost `(` is on top of the stack
+func popUntilParen(operators *stack.Stack[Token], output *queue.Queue[Token]) {
+	for operators.Length > 0 {
+		top, _ := operators.Peek()
+		if top.Kind == Token_Left_Paren {
+			return
+		}
+		operators.Pop()
+		output.Enqueue(top)
+	}
+}
@@ -0,0 +1,174 @@
+package expression
+
+import (
+	"fmt"
+	"strconv"
+	"unicode"
+)
+
+type Token_Kind uint8
+
+const (
+	Token_Number Token_Kind = iota
+	Token_Boolean
+	Token_Variable
+	Token_Function
+	Token_Operator
+	Token_Unary_Operator
+	Token_Left_Paren
+	Token_Right_Paren
+	Token_Comma
+)
+
+// Lexeme of an expression, `Column` is 1-based and counts runes
+type Token struct {
+	Kind   Token_Kind
+	Text   string
+	Column int
+
+	// Parsed literal for `Token_Number` and `Token_Boolean`
+	Value any
+
+	// Number of arguments, set on `Token_Function` by `To_RPN`
+	Arity int
+}
+
+// Tokenize, parse or evaluation failure pointing at the offending token
+type Error struct {
+	Column  int
+	Message string
+}
+
+func (err *Error) Error() string {
+	return fmt.Sprintf("column %d: %s", err.Column, err.Message)
+}
+
+func columnError(column int, format string, arguments ...any) *Error {
+	return &Error{
+		Column:  column,
+		Message: fmt.Sprintf(format, arguments...),
+	}
+}
+
+// Longest operators first so `<=` is not read as `<` then `=`
+var operator_texts = []string{"||", "&&", "==", "!=", "<=", ">=", "+", "-", "*", "/", "%", "^", "<", ">", "!"}
+
+/**
+ * Split infix expression into tokens, identifiers directly followed by `(`
+ * become `Token_Function` and every other identifier a `Token_Variable`
+ *
+ * @note - unary operators are told apart from binary ones later by `To_RPN`
+ */
+func Tokenize(input string) ([]Token, error) {
+	runes := []rune(input)
+	tokens := make([]Token, 0)
+
+	for i := 0; i < len(runes); {
+		character := runes[i]
+		column := i + 1
+
+		switch {
+		case unicode.IsSpace(character):
+			i++
+
+		case unicode.IsDigit(character) || (character == '.' && i+1 < len(runes) && unicode.IsDigit(runes[i+1])):
+			end := scanNumber(runes, i)
+			text := string(runes[i:end])
+			number, err := strconv.ParseFloat(text, 64)
+			if err != nil {
+				return nil, columnError(column, "Invalid number %q", text)
+			}
+			tokens = append(tokens, Token{Kind: Token_Number, Text: text, Column: column, Value: number})
+			i = end
+
+		case unicode.IsLetter(character) || character == '_':
+			end := i + 1
+			for end < len(runes) && (unicode.IsLetter(runes[end]) || unicode.IsDigit(runes[end]) || runes[end] == '_') {
+				end++
+			}
+			text := string(runes[i:end])
+
+			token := Token{Kind: Token_Variable, Text: text, Column: column}
+			if text == "true" || text == "false" {
+				token.Kind = Token_Boolean
+				token.Value = text == "true"
+			} else if next := skipSpace(runes, end); next < len(runes) && runes[next] == '(' {
+				token.Kind = Token_Function
+			}
+			tokens = append(tokens, token)
+			i = end
+
+		case character == '(':
+			tokens = append(tokens, Token{Kind: Token_Left_Paren, Text: "(", Column: column})
+			i++
+
+		case character == ')':
+			tokens = append(tokens, Token{Kind: Token_Right_Paren, Text: ")", Column: column})
+			i++
+
+		case character == ',':
+			tokens = append(tokens, Token{Kind: Token_Comma, Text: ",", Column: column})
+			i++
+
+		default:
+			text := matchOperator(runes, i)
+			if text == "" {
+				return nil, columnError(column, "Unexpected character %q", character)
+			}
+			tokens = append(tokens, Token{Kind: Token_Operator, Text: text, Column: column})
+			i += len([]rune(text))
+		}
+	}
+
+	return tokens, nil
+}
+
+// Returns index one past digits, optional fraction and optional exponent
+func scanNumber(runes []rune, start int) int {
+	end := start
+	for end < len(runes) && unicode.IsDigit(runes[end]) {
+		end++
+	}
+
+	if end < len(runes) && runes[end] == '.' {
+		end++
+		for end < len(runes) && unicode.IsDigit(runes[end]) {
+			end++
+		}
+	}
+
+	if end < len(runes) && (runes[end] == 'e' || runes[end] == 'E') {
+		exponent := end + 1
+		if exponent < len(runes) && (runes[exponent] == '+' || runes[exponent] == '-') {
+			exponent++
+		}
+		if exponent < len(runes) && unicode.IsDigit(runes[exponent]) {
+			end = exponent
+			for end < len(runes) && unicode.IsDigit(runes[end]) {
+				end++
+			}
+		}
+	}
+
+	return end
+}
+
+func skipSpace(runes []rune, index int) int {
+	for index < len(runes) && unicode.IsSpace(runes[index]) {
+		index++
+	}
+	return index
+}
+
+func matchOperator(runes []rune, index int) string {
+	for _, text := range operator_texts {
+		candidate := []rune(text)
+		if index+len(candidate) > len(runes) {
+			continue
+		}
+		if string(runes[index:index+len(candidate)]) == text {
+			return text
+		}
+	}
+	return ""
+}
@@ -0,0 +1,15 @@
+module history
+
+go 1.23
+
+require (
+	common-errors v0.0.0
+	doubly-linked-list v0.0.0
+	stack v0.0.0
+)
+
+replace (
+	common-errors => ../common-errors
+	doubly-linked-list => ../doubly-linked-list
+	stack => ../stack
+)
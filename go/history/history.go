@@ -0,0 +1,338 @@
+package history
+
+import (
+	"errors"
+	"slices"
+	"stack"
+
+	common_errors "common-errors"
+	doubly_linked_list "doubly-linked-list"
+)
+
+var (
+	// Returned by `Undo`, `Redo` and `Clear` while a transaction is open
+	ErrTransactionInProgress = errors.New("Transaction in progress")
+
+	// Returned by `Commit` and `Rollback` when no transaction is open
+	ErrNoTransaction = errors.New("No transaction in progress")
+)
+
+// Reversible action, `Undo` must restore the state `Do` changed
+type Command interface {
+	Do() error
+	Undo() error
+}
+
+// Adapts a pair of closures to `Command`
+type Command_Func struct {
+	Do_Func   func() error
+	Undo_Func func() error
+}
+
+func (command Command_Func) Do() error {
+	return command.Do_Func()
+}
+
+func (command Command_Func) Undo() error {
+	return command.Undo_Func()
+}
+
+// What changed, passed to every subscriber
+type Event_Kind uint8
+
+const (
+	Event_Execute Event_Kind = iota
+	Event_Undo
+	Event_Redo
+	Event_Evict
+	Event_Clear
+)
+
+type Event struct {
+	Kind Event_Kind
+
+	// Command that ran, `nil` for `Event_Evict` and `Event_Clear`
+	Command Command
+
+	Undo_Length uint
+	Redo_Length uint
+}
+
+// Commands grouped by a transaction, replayed in order and undone in reverse
+type group struct {
+	commands []Command
+}
+
+func (group *group) Do() error {
+	for i, command := range group.commands {
+		if err := command.Do(); err != nil {
+			// Leave state as it was before the group started
+			undoAll(group.commands[:i])
+			return err
+		}
+	}
+	return nil
+}
+
+func (group *group) Undo() error {
+	for i := len(group.commands) - 1; i >= 0; i-- {
+		if err := group.commands[i].Undo(); err != nil {
+			// Leave state as it was before undo started
+			for _, command := range group.commands[i+1:] {
+				command.Do()
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func undoAll(commands []Command) {
+	for i := len(commands) - 1; i >= 0; i-- {
+		commands[i].Undo()
+	}
+}
+
+// Undo and redo manager, undo entries sit in a `doubly_linked_list.List` so
+// the oldest is evicted in `O(1)` and redo entries in a `stack.Stack`
+//
+// ## Example
+//
+//	history := History{Max_Depth: 100}
+//	history.Execute(Command_Func{
+//		Do_Func:   func() error { text += "a"; return nil },
+//		Undo_Func: func() error { text = text[:len(text)-1]; return nil },
+//	})
+//	history.Undo() // text == ""
+//	history.Redo() // text == "a"
+//
+// @notes
+//
+// - Executing a new command after an undo clears the redo branch
+// - When `Max_Depth` is non-zero oldest undo entries are evicted past it
+// - Subscribers are called in registration order
+// - Not safe for concurrent use, and must not be copied after first use
+type History struct {
+	Max_Depth uint
+
+	// Newest entry at back
+	undo doubly_linked_list.List[Command]
+	redo stack.Stack[Command]
+
+	// Open transactions, innermost on top
+	transactions stack.Stack[*group]
+
+	subscribers []subscriber
+	next_id     int
+}
+
+type subscriber struct {
+	id       int
+	callback func(Event)
+}
+
+/**
+ * Run command and record it for undo, nothing is recorded if `Do` fails
+ */
+func (history *History) Execute(command Command) error {
+	if err := command.Do(); err != nil {
+		return err
+	}
+
+	if transaction, err := history.transactions.Peek(); err == nil {
+		transaction.commands = append(transaction.commands, command)
+		return nil
+	}
+
+	history.record(command)
+	return nil
+}
+
+/**
+ * Reverse most recent command and move it onto redo stack
+ */
+func (history *History) Undo() error {
+	if history.transactions.Length > 0 {
+		return ErrTransactionInProgress
+	}
+
+	node := history.undo.Cursor_Back().Node()
+	if node == nil {
+		return &common_errors.Empty_Error{Container: "Undo history"}
+	}
+
+	command := node.Value()
+	if err := command.Undo(); err != nil {
+		return err
+	}
+
+	history.undo.Remove_Node(node)
+
+	history.redo.Push(command)
+	history.emit(Event_Undo, command)
+	return nil
+}
+
+/**
+ * Re-run most recently undone command and move it back onto undo stack
+ */
+func (history *History) Redo() error {
+	if history.transactions.Length > 0 {
+		return ErrTransactionInProgress
+	}
+
+	command, err := history.redo.Pop()
+	if err != nil {
+		return &common_errors.Empty_Error{Container: "Redo history"}
+	}
+
+	if err := command.Do(); err != nil {
+		history.redo.Push(command)
+		return err
+	}
+
+	history.undo.Append(command)
+	evicted := history.evict()
+	history.emit(Event_Redo, command)
+	if evicted {
+		history.emit(Event_Evict, nil)
+	}
+	return nil
+}
+
+func (history *History) Can_Undo() bool {
+	return history.undo.Length > 0 && history.transactions.Length == 0
+}
+
+func (history *History) Can_Redo() bool {
+	return history.redo.Length > 0 && history.transactions.Length == 0
+}
+
+/**
+ * Forget every recorded command without running anything, refused while a
+ * transaction is open since its commands have already run
+ */
+func (history *History) Clear() error {
+	if history.transactions.Length > 0 {
+		return ErrTransactionInProgress
+	}
+
+	history.undo = doubly_linked_list.List[Command]{}
+	history.redo = stack.Stack[Command]{}
+	history.emit(Event_Clear, nil)
+	return nil
+}
+
+/**
+ * Start grouping executed commands so they undo and redo as one, transactions
+ * may nest and an inner commit folds into the outer group
+ */
+func (history *History) Begin() {
+	history.transactions.Push(&group{})
+}
+
+/**
+ * Close innermost transaction, recording its commands as a single entry
+ */
+func (history *History) Commit() error {
+	transaction, err := history.transactions.Pop()
+	if err != nil {
+		return ErrNoTransaction
+	}
+
+	if len(transaction.commands) == 0 {
+		return nil
+	}
+
+	if outer, err := history.transactions.Peek(); err == nil {
+		outer.commands = append(outer.commands, transaction)
+		return nil
+	}
+
+	history.record(transaction)
+	return nil
+}
+
+/**
+ * Close innermost transaction, undoing its commands in reverse order
+ */
+func (history *History) Rollback() error {
+	transaction, err := history.transactions.Pop()
+	if err != nil {
+		return ErrNoTransaction
+	}
+
+	return transaction.Undo()
+}
+
+/**
+ * Run callback inside a transaction, committing when it returns nil and
+ * rolling back otherwise
+ */
+func (history *History) Transaction(callback func() error) error {
+	history.Begin()
+
+	if err := callback(); err != nil {
+		if rollback_err := history.Rollback(); rollback_err != nil {
+			return errors.Join(err, rollback_err)
+		}
+		return err
+	}
+
+	return history.Commit()
+}
+
+/**
+ * Register callback for every change, returns function that unsubscribes it
+ */
+func (history *History) Subscribe(callback func(Event)) func() {
+	id := history.next_id
+	history.next_id++
+	history.subscribers = append(history.subscribers, subscriber{id, callback})
+
+	return func() {
+		// Copy so an `emit` ranging over the old slice is not disturbed
+		history.subscribers = slices.DeleteFunc(slices.Clone(history.subscribers), func(entry subscriber) bool {
+			return entry.id == id
+		})
+	}
+}
+
+// Push onto undo list, drop redo branch and enforce `Max_Depth`, then emit
+// the execute ahead of the eviction it caused
+func (history *History) record(command Command) {
+	history.undo.Append(command)
+	history.redo = stack.Stack[Command]{}
+	evicted := history.evict()
+
+	history.emit(Event_Execute, command)
+	if evicted {
+		history.emit(Event_Evict, nil)
+	}
+}
+
+// Drop oldest undo entries from front until within `Max_Depth`, returns
+// whether any were dropped
+func (history *History) evict() bool {
+	if history.Max_Depth == 0 || history.undo.Length <= history.Max_Depth {
+		return false
+	}
+
+	for history.undo.Length > history.Max_Depth {
+		history.undo.Remove_Node(history.undo.Cursor_Front().Node())
+	}
+	return true
+}
+
+func (history *History) emit(kind Event_Kind, command Command) {
+	event := Event{
+		Kind:        kind,
+		Command:     command,
+		Undo_Length: history.undo.Length,
+		Redo_Length: history.redo.Length,
+	}
+
+	for _, entry := range history.subscribers {
+		entry.callback(event)
+	}
+}
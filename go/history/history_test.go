@@ -0,0 +1,334 @@
+package history
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	common_errors "common-errors"
+)
+
+// Minimal editable document for exercising commands
+type document struct {
+	text string
+}
+
+func (doc *document) insert(suffix string) Command {
+	return Command_Func{
+		Do_Func: func() error {
+			doc.text += suffix
+			return nil
+		},
+		Undo_Func: func() error {
+			doc.text = doc.text[:len(doc.text)-len(suffix)]
+			return nil
+		},
+	}
+}
+
+func Test_Undo_and_Redo_reverse_and_replay_commands(t *testing.T) {
+	doc := document{}
+	history := History{}
+
+	history.Execute(doc.insert("a"))
+	history.Execute(doc.insert("b"))
+	history.Execute(doc.insert("c"))
+
+	steps := []struct {
+		action   func() error
+		expected string
+	}{
+		{history.Undo, "ab"},
+		{history.Undo, "a"},
+		{history.Redo, "ab"},
+		{history.Redo, "abc"},
+		{history.Undo, "ab"},
+	}
+
+	for i, step := range steps {
+		if err := step.action(); err != nil {
+			t.Fatalf(`Step %v unexpected error %v`, i, err)
+		}
+		if doc.text != step.expected {
+			t.Fatalf(`Step %v expected text %q but got %q`, i, step.expected, doc.text)
+		}
+	}
+}
+
+func Test_Undo_and_Redo_error_when_nothing_recorded(t *testing.T) {
+	history := History{}
+
+	if err := history.Undo(); !errors.Is(err, common_errors.ErrEmpty) {
+		t.Fatalf(`Expected ErrEmpty but got %v`, err)
+	}
+	if err := history.Redo(); !errors.Is(err, common_errors.ErrEmpty) {
+		t.Fatalf(`Expected ErrEmpty but got %v`, err)
+	}
+	if history.Can_Undo() || history.Can_Redo() {
+		t.Fatalf(`Expected empty history to report nothing to undo or redo`)
+	}
+}
+
+func Test_Execute_after_Undo_clears_redo_branch(t *testing.T) {
+	doc := document{}
+	history := History{}
+
+	history.Execute(doc.insert("a"))
+	history.Execute(doc.insert("b"))
+	history.Undo()
+
+	if !history.Can_Redo() {
+		t.Fatalf(`Expected redo to be available after undo`)
+	}
+
+	history.Execute(doc.insert("x"))
+
+	if history.Can_Redo() {
+		t.Fatalf(`Expected new command to clear redo branch`)
+	}
+	if doc.text != "ax" {
+		t.Fatalf(`Expected text "ax" but got %q`, doc.text)
+	}
+}
+
+func Test_Execute_does_not_record_failing_command(t *testing.T) {
+	history := History{}
+
+	failure := errors.New("nope")
+	err := history.Execute(Command_Func{
+		Do_Func:   func() error { return failure },
+		Undo_Func: func() error { return nil },
+	})
+
+	if !errors.Is(err, failure) {
+		t.Fatalf(`Expected failure error but got %v`, err)
+	}
+	if history.Can_Undo() {
+		t.Fatalf(`Expected failing command not to be recorded`)
+	}
+}
+
+func Test_Max_Depth_evicts_oldest_entries(t *testing.T) {
+	doc := document{}
+	history := History{Max_Depth: 2}
+
+	evictions := 0
+	history.Subscribe(func(event Event) {
+		if event.Kind == Event_Evict {
+			evictions++
+		}
+	})
+
+	for _, letter := range []string{"a", "b", "c", "d"} {
+		history.Execute(doc.insert(letter))
+	}
+
+	if evictions != 2 {
+		t.Fatalf(`Expected 2 evictions but got %v`, evictions)
+	}
+
+	history.Undo()
+	history.Undo()
+	if err := history.Undo(); !errors.Is(err, common_errors.ErrEmpty) {
+		t.Fatalf(`Expected third undo to fail past Max_Depth with ErrEmpty but got %v`, err)
+	}
+	if doc.text != "ab" {
+		t.Fatalf(`Expected text "ab" but got %q`, doc.text)
+	}
+}
+
+func Test_Transaction_groups_commands_into_one_entry(t *testing.T) {
+	doc := document{}
+	history := History{}
+
+	history.Execute(doc.insert("a"))
+
+	err := history.Transaction(func() error {
+		history.Execute(doc.insert("b"))
+		history.Execute(doc.insert("c"))
+
+		// Nested transaction folds into outer group
+		history.Begin()
+		history.Execute(doc.insert("d"))
+		return history.Commit()
+	})
+	if err != nil {
+		t.Fatalf(`Unexpected error %v`, err)
+	}
+
+	history.Undo()
+	if doc.text != "a" {
+		t.Fatalf(`Expected grouped undo to leave "a" but got %q`, doc.text)
+	}
+
+	history.Redo()
+	if doc.text != "abcd" {
+		t.Fatalf(`Expected grouped redo to restore "abcd" but got %q`, doc.text)
+	}
+}
+
+func Test_Transaction_rolls_back_on_error(t *testing.T) {
+	doc := document{}
+	history := History{}
+
+	failure := errors.New("abort")
+	err := history.Transaction(func() error {
+		history.Execute(doc.insert("x"))
+		history.Execute(doc.insert("y"))
+		return failure
+	})
+
+	if !errors.Is(err, failure) {
+		t.Fatalf(`Expected failure error but got %v`, err)
+	}
+	if doc.text != "" {
+		t.Fatalf(`Expected rollback to restore empty text but got %q`, doc.text)
+	}
+	if history.Can_Undo() {
+		t.Fatalf(`Expected rolled back transaction not to be recorded`)
+	}
+}
+
+func Test_Undo_is_refused_inside_transaction(t *testing.T) {
+	doc := document{}
+	history := History{}
+
+	history.Execute(doc.insert("a"))
+	history.Begin()
+
+	if err := history.Undo(); !errors.Is(err, ErrTransactionInProgress) {
+		t.Fatalf(`Expected ErrTransactionInProgress but got %v`, err)
+	}
+
+	history.Commit()
+
+	if err := history.Commit(); !errors.Is(err, ErrNoTransaction) {
+		t.Fatalf(`Expected ErrNoTransaction committing without transaction but got %v`, err)
+	}
+}
+
+func Test_Subscribe_reports_changes_until_unsubscribed(t *testing.T) {
+	doc := document{}
+	history := History{}
+
+	events := make([]Event, 0)
+	unsubscribe := history.Subscribe(func(event Event) {
+		events = append(events, event)
+	})
+
+	history.Execute(doc.insert("a"))
+	history.Undo()
+	history.Redo()
+	if err := history.Clear(); err != nil {
+		t.Fatalf(`Unexpected error %v`, err)
+	}
+	unsubscribe()
+	history.Execute(doc.insert("b"))
+
+	expected := []Event_Kind{Event_Execute, Event_Undo, Event_Redo, Event_Clear}
+	if len(events) != len(expected) {
+		t.Fatalf(`Expected %v events but got %v`, len(expected), len(events))
+	}
+
+	for i, kind := range expected {
+		if events[i].Kind != kind {
+			t.Fatalf(`Event %v expected kind %v but got %v`, i, kind, events[i].Kind)
+		}
+	}
+
+	if events[1].Undo_Length != 0 || events[1].Redo_Length != 1 {
+		t.Fatalf(`Expected undo event lengths 0 and 1 but got %v and %v`, events[1].Undo_Length, events[1].Redo_Length)
+	}
+}
+
+func Test_Subscribers_are_called_in_registration_order(t *testing.T) {
+	doc := document{}
+	history := History{}
+
+	order := []int{}
+	unsubscribers := []func(){}
+	for i := 0; i < 8; i++ {
+		unsubscribers = append(unsubscribers, history.Subscribe(func(Event) {
+			order = append(order, i)
+		}))
+	}
+	unsubscribers[3]()
+
+	history.Execute(doc.insert("a"))
+
+	expected := []int{0, 1, 2, 4, 5, 6, 7}
+	if !slices.Equal(order, expected) {
+		t.Fatalf(`Expected subscribers called in order %v but got %v`, expected, order)
+	}
+}
+
+func Test_Clear_is_refused_inside_transaction(t *testing.T) {
+	doc := document{}
+	history := History{}
+
+	history.Execute(doc.insert("a"))
+	history.Begin()
+	history.Execute(doc.insert("b"))
+
+	if err := history.Clear(); !errors.Is(err, ErrTransactionInProgress) {
+		t.Fatalf(`Expected ErrTransactionInProgress but got %v`, err)
+	}
+	if err := history.Commit(); err != nil {
+		t.Fatalf(`Unexpected error %v`, err)
+	}
+
+	history.Undo()
+	history.Undo()
+	if doc.text != "" {
+		t.Fatalf(`Expected both entries kept through refused Clear but got %q`, doc.text)
+	}
+}
+
+func Test_Max_Depth_keeps_newest_entries_over_many_executes(t *testing.T) {
+	doc := document{}
+	history := History{Max_Depth: 3}
+
+	for i := 0; i < 1000; i++ {
+		history.Execute(doc.insert("x"))
+	}
+	if history.undo.Length != 3 {
+		t.Fatalf(`Expected 3 undo entries but got %v`, history.undo.Length)
+	}
+
+	for history.Can_Undo() {
+		history.Undo()
+	}
+	if len(doc.text) != 997 {
+		t.Fatalf(`Expected only the newest 3 entries undone but text has length %v`, len(doc.text))
+	}
+}
+
+func Test_Execute_is_reported_before_the_eviction_it_caused(t *testing.T) {
+	doc := document{}
+	history := History{Max_Depth: 1}
+
+	events := []Event{}
+	history.Subscribe(func(event Event) {
+		events = append(events, event)
+	})
+
+	history.Execute(doc.insert("a"))
+	history.Execute(doc.insert("b"))
+
+	expected := []Event_Kind{Event_Execute, Event_Execute, Event_Evict}
+	if len(events) != len(expected) {
+		t.Fatalf(`Expected %v events but got %v`, len(expected), len(events))
+	}
+	for i, kind := range expected {
+		if events[i].Kind != kind {
+			t.Fatalf(`Event %v expected kind %v but got %v`, i, kind, events[i].Kind)
+		}
+		if events[i].Undo_Length != 1 {
+			t.Fatalf(`Event %v expected undo length 1 but got %v`, i, events[i].Undo_Length)
+		}
+	}
+
+	if err := history.Clear(); err != nil || history.Can_Undo() {
+		t.Fatalf(`Expected Clear to empty undo history but got %v`, err)
+	}
+}
@@ -0,0 +1,136 @@
+package lru
+
+// Adaptive replacement cache ordering from Megiddo and Modha, `t1` holds keys
+// seen once recently and `t2` keys seen at least twice, `b1` and `b2` are
+// ghost lists remembering keys evicted from each, and a ghost hit shifts
+// target size `p` of `t1` toward whichever side would have kept the key
+//
+// @notes
+//
+// - Ghosts hold keys only, at most `capacity` of them across `b1` and `b2`
+// - Scans pass through `t1` without flushing frequently used keys in `t2`
+type ARC_Policy[K comparable] struct {
+	capacity uint
+	p        uint
+	t1       *key_list[K]
+	t2       *key_list[K]
+	b1       *key_list[K]
+	b2       *key_list[K]
+}
+
+// Capacity counts resident keys and is at least 1
+func New_ARC_Policy[K comparable](capacity uint) *ARC_Policy[K] {
+	return &ARC_Policy[K]{
+		capacity: max(capacity, 1),
+		t1:       newKeyList[K](),
+		t2:       newKeyList[K](),
+		b1:       newKeyList[K](),
+		b2:       newKeyList[K](),
+	}
+}
+
+func (policy *ARC_Policy[K]) Hit(key K) {
+	if policy.t1.remove(key) {
+		policy.t2.pushFront(key)
+		return
+	}
+	policy.t2.moveToFront(key)
+}
+
+func (policy *ARC_Policy[K]) Admit(key K) (K, bool) {
+	var victim K
+	evicted := false
+
+	switch {
+	case policy.b1.contains(key):
+		// Recency side would have kept it, grow `t1` target
+		policy.p = min(policy.capacity, policy.p+max(policy.b2.length()/policy.b1.length(), 1))
+		victim, evicted = policy.replace(false)
+		policy.b1.remove(key)
+		policy.t2.pushFront(key)
+
+	case policy.b2.contains(key):
+		// Frequency side would have kept it, shrink `t1` target
+		policy.p -= min(policy.p, max(policy.b1.length()/policy.b2.length(), 1))
+		victim, evicted = policy.replace(true)
+		policy.b2.remove(key)
+		policy.t2.pushFront(key)
+
+	default:
+		l1 := policy.t1.length() + policy.b1.length()
+		total := l1 + policy.t2.length() + policy.b2.length()
+
+		if l1 >= policy.capacity {
+			if policy.t1.length() < policy.capacity {
+				policy.b1.popBack()
+				victim, evicted = policy.replace(false)
+			} else {
+				victim, evicted = policy.t1.popBack()
+			}
+		} else if total >= policy.capacity {
+			if total >= 2*policy.capacity {
+				policy.b2.popBack()
+			}
+			victim, evicted = policy.replace(false)
+		}
+
+		policy.t1.pushFront(key)
+	}
+
+	return victim, evicted
+}
+
+func (policy *ARC_Policy[K]) Remove(key K) {
+	if !policy.t1.remove(key) {
+		policy.t2.remove(key)
+	}
+}
+
+func (policy *ARC_Policy[K]) Evict() (K, bool) {
+	if policy.Length() == 0 {
+		var result K
+		return result, false
+	}
+	return policy.demote(false)
+}
+
+func (policy *ARC_Policy[K]) Length() uint {
+	return policy.t1.length() + policy.t2.length()
+}
+
+// Evict from `t1` or `t2` into its ghost list, only when resident keys are
+// at capacity, since explicit removals can leave room
+func (policy *ARC_Policy[K]) replace(in_b2 bool) (K, bool) {
+	if policy.Length() < policy.capacity {
+		var result K
+		return result, false
+	}
+	return policy.demote(in_b2)
+}
+
+// Move least recent key of `t1` or `t2`, whichever is over its target, into
+// its ghost list, then trim ghosts back to `capacity`
+func (policy *ARC_Policy[K]) demote(in_b2 bool) (K, bool) {
+	var victim K
+
+	t1_length := policy.t1.length()
+	if t1_length > 0 && (t1_length > policy.p || (in_b2 && t1_length == policy.p) || policy.t2.length() == 0) {
+		victim, _ = policy.t1.popBack()
+		policy.b1.pushFront(victim)
+	} else {
+		victim, _ = policy.t2.popBack()
+		policy.b2.pushFront(victim)
+	}
+
+	// Evictions by `Cache` weight happen below capacity, where `Admit` never
+	// trims, so ghosts would otherwise grow without bound
+	for policy.b1.length()+policy.b2.length() > policy.capacity {
+		if policy.b1.length() >= policy.b2.length() {
+			policy.b1.popBack()
+		} else {
+			policy.b2.popBack()
+		}
+	}
+
+	return victim, true
+}
@@ -0,0 +1,16 @@
+package lru
+
+import "time"
+
+// Source of time for TTL expiry, `delay_queue.Fake_Clock` and similar test
+// clocks satisfy it too
+type Clock interface {
+	Now() time.Time
+}
+
+// Wall clock backed by the `time` package
+type System_Clock struct{}
+
+func (System_Clock) Now() time.Time {
+	return time.Now()
+}
@@ -0,0 +1,12 @@
+module lru
+
+go 1.24
+
+require doubly-linked-list v0.0.0
+
+require common-errors v0.0.0 // indirect
+
+replace (
+	common-errors => ../common-errors
+	doubly-linked-list => ../doubly-linked-list
+)
@@ -0,0 +1,114 @@
+package lru
+
+import doubly_linked_list "doubly-linked-list"
+
+// Keys read the same number of times, most recent at head
+type frequency_bucket[K comparable] struct {
+	count uint
+	keys  *key_list[K]
+}
+
+// Least frequently used ordering with `O(1)` operations, buckets of equal
+// frequency sit in a list ordered by ascending count, so the victim is always
+// at the tail of the first bucket, which breaks ties by recency
+//
+// @note - counts start at 1 on admission and are forgotten on eviction
+type LFU_Policy[K comparable] struct {
+	capacity uint
+	length   uint
+	buckets  doubly_linked_list.List[*frequency_bucket[K]]
+	index    map[K]*doubly_linked_list.Node[*frequency_bucket[K]]
+}
+
+// Capacity counts keys and is at least 1
+func New_LFU_Policy[K comparable](capacity uint) *LFU_Policy[K] {
+	return &LFU_Policy[K]{
+		capacity: max(capacity, 1),
+		index:    map[K]*doubly_linked_list.Node[*frequency_bucket[K]]{},
+	}
+}
+
+func (policy *LFU_Policy[K]) Hit(key K) {
+	node, ok := policy.index[key]
+	if !ok {
+		return
+	}
+
+	bucket := node.Value()
+	cursor := node.Cursor()
+
+	// Next bucket along may already hold `count + 1`, otherwise make one
+	var target *doubly_linked_list.Node[*frequency_bucket[K]]
+	if cursor.Next() && cursor.Node().Value().count == bucket.count+1 {
+		target = cursor.Node()
+	} else {
+		target, _ = node.Cursor().Insert_After(&frequency_bucket[K]{
+			count: bucket.count + 1,
+			keys:  newKeyList[K](),
+		})
+	}
+
+	bucket.keys.remove(key)
+	target.Value().keys.pushFront(key)
+	policy.index[key] = target
+
+	if bucket.keys.length() == 0 {
+		policy.buckets.Remove_Node(node)
+	}
+}
+
+func (policy *LFU_Policy[K]) Admit(key K) (K, bool) {
+	var victim K
+	evicted := false
+	if policy.length >= policy.capacity {
+		victim, evicted = policy.Evict()
+	}
+
+	first := policy.buckets.Cursor_Front().Node()
+	if first == nil || first.Value().count != 1 {
+		first = policy.buckets.Prepend(&frequency_bucket[K]{
+			count: 1,
+			keys:  newKeyList[K](),
+		})
+	}
+
+	first.Value().keys.pushFront(key)
+	policy.index[key] = first
+	policy.length++
+
+	return victim, evicted
+}
+
+func (policy *LFU_Policy[K]) Remove(key K) {
+	node, ok := policy.index[key]
+	if !ok {
+		return
+	}
+
+	node.Value().keys.remove(key)
+	delete(policy.index, key)
+	policy.length--
+	if node.Value().keys.length() == 0 {
+		policy.buckets.Remove_Node(node)
+	}
+}
+
+func (policy *LFU_Policy[K]) Evict() (K, bool) {
+	first := policy.buckets.Cursor_Front().Node()
+	if first == nil {
+		var result K
+		return result, false
+	}
+
+	victim, _ := first.Value().keys.popBack()
+	delete(policy.index, victim)
+	policy.length--
+	if first.Value().keys.length() == 0 {
+		policy.buckets.Remove_Node(first)
+	}
+	return victim, true
+}
+
+func (policy *LFU_Policy[K]) Length() uint {
+	return policy.length
+}
@@ -0,0 +1,284 @@
+package lru
+
+import "time"
+
+type Evict_Reason uint8
+
+const (
+	// Picked by `Policy` to stay within `Capacity`
+	Evict_Capacity Evict_Reason = iota
+
+	// TTL ran out before entry was read again
+	Evict_Expired
+
+	// Removed by an explicit call to `Remove`
+	Evict_Removed
+)
+
+type Options[K comparable, V any] struct {
+	// Upper bound on total weight, 0 means unbounded
+	Capacity uint
+
+	// Weight of each entry, `nil` weighs every entry as 1 so `Capacity`
+	// counts entries
+	Weigher func(key K, value V) uint
+
+	// Lifetime of an entry from its last `Put`, 0 disables expiry
+	TTL time.Duration
+
+	// Defaults to `System_Clock`
+	Clock Clock
+
+	// Builds eviction order for a new cache, `nil` uses an unbounded
+	// `LRU_Policy` so only `Capacity` limits size
+	Policy func() Policy[K]
+
+	// Called after an entry leaves the cache for any reason, except being
+	// overwritten by `Put`
+	On_Evict func(key K, value V, reason Evict_Reason)
+}
+
+type Stats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+}
+
+type entry[K comparable, V any] struct {
+	key     K
+	value   V
+	weight  uint
+	expires time.Time
+}
+
+// Cache of weighted entries whose eviction order is delegated to a `Policy`,
+// least recently used by default, a map holds entries so every lookup is
+// `O(1)` and eviction costs whatever the policy does
+//
+// ## Example
+//
+//	cache := New_Cache(Options[string, int]{Capacity: 2})
+//	cache.Put("a", 1)
+//	cache.Put("b", 2)
+//	cache.Get("a")    // "a" is now most recently used
+//	cache.Put("c", 3) // evicts "b"
+//
+// @notes
+//
+// - Any `Policy` gets weights, TTL and `On_Evict`, a policy with its own
+// key capacity evicts on admission as well as when over `Capacity`
+// - Not safe for concurrent use, see `Sharded_Cache`
+// - TTL counts from the last `Put`, reads do not extend it
+// - Expired entries are dropped lazily on access, `Remove_Expired` sweeps
+// the rest
+type Cache[K comparable, V any] struct {
+	options Options[K, V]
+	policy  Policy[K]
+	entries map[K]*entry[K, V]
+	weight  uint
+	stats   Stats
+}
+
+func New_Cache[K comparable, V any](options Options[K, V]) *Cache[K, V] {
+	if options.Clock == nil {
+		options.Clock = System_Clock{}
+	}
+
+	policy := Policy[K](New_LRU_Policy[K](0))
+	if options.Policy != nil {
+		policy = options.Policy()
+	}
+
+	return &Cache[K, V]{
+		options: options,
+		policy:  policy,
+		entries: map[K]*entry[K, V]{},
+	}
+}
+
+/**
+ * Returns value for key and reports the hit to policy, counts as hit or miss
+ */
+func (cache *Cache[K, V]) Get(key K) (V, bool) {
+	item, ok := cache.lookup(key)
+	if !ok {
+		cache.stats.Misses++
+		var result V
+		return result, false
+	}
+
+	cache.stats.Hits++
+	cache.policy.Hit(key)
+	return item.value, true
+}
+
+/**
+ * Returns value for key without touching recency or statistics, an expired
+ * entry reads as missing but is left for `Get` or `Remove_Expired` to drop
+ */
+func (cache *Cache[K, V]) Peek(key K) (V, bool) {
+	item, ok := cache.entries[key]
+	if !ok || cache.isExpired(item, cache.options.Clock.Now()) {
+		var result V
+		return result, false
+	}
+	return item.value, true
+}
+
+/**
+ * Insert value, or overwrite it and report the hit to policy, then evict
+ * whatever keys policy picks until within `Capacity`
+ *
+ * @note - an entry heavier than `Capacity` on its own is evicted immediately,
+ * along with any value it overwrote, and every other entry is left alone
+ */
+func (cache *Cache[K, V]) Put(key K, value V) {
+	item := &entry[K, V]{
+		key:    key,
+		value:  value,
+		weight: 1,
+	}
+	if cache.options.Weigher != nil {
+		item.weight = cache.options.Weigher(key, value)
+	}
+	if cache.options.TTL > 0 {
+		item.expires = cache.options.Clock.Now().Add(cache.options.TTL)
+	}
+
+	previous, resident := cache.entries[key]
+	if resident {
+		cache.weight -= previous.weight
+	}
+
+	if cache.options.Capacity > 0 && item.weight > cache.options.Capacity {
+		if resident {
+			delete(cache.entries, key)
+			cache.policy.Remove(key)
+		}
+		cache.report(item, Evict_Capacity)
+		return
+	}
+
+	cache.entries[key] = item
+	cache.weight += item.weight
+
+	if resident {
+		cache.policy.Hit(key)
+	} else if victim, evicted := cache.policy.Admit(key); evicted {
+		cache.drop(cache.entries[victim], Evict_Capacity)
+	}
+
+	cache.shrink()
+}
+
+/**
+ * Remove key, returns false when it was not cached
+ */
+func (cache *Cache[K, V]) Remove(key K) bool {
+	item, ok := cache.entries[key]
+	if !ok {
+		return false
+	}
+
+	cache.evict(item, Evict_Removed)
+	return true
+}
+
+/**
+ * Drop every expired entry and return how many were dropped
+ */
+func (cache *Cache[K, V]) Remove_Expired() uint {
+	if cache.options.TTL <= 0 {
+		return 0
+	}
+
+	now := cache.options.Clock.Now()
+	removed := uint(0)
+
+	// Deleting the current key while ranging over a map is safe
+	for _, item := range cache.entries {
+		if cache.isExpired(item, now) {
+			cache.evict(item, Evict_Expired)
+			removed++
+		}
+	}
+
+	return removed
+}
+
+/**
+ * Returns number of cached entries, expired entries not yet dropped included
+ */
+func (cache *Cache[K, V]) Length() uint {
+	return uint(len(cache.entries))
+}
+
+/**
+ * Returns total weight of cached entries
+ */
+func (cache *Cache[K, V]) Weight() uint {
+	return cache.weight
+}
+
+/**
+ * Returns hit, miss and eviction counters since creation
+ */
+func (cache *Cache[K, V]) Stats() Stats {
+	return cache.stats
+}
+
+// Find live entry for key, dropping it first when expired
+func (cache *Cache[K, V]) lookup(key K) (*entry[K, V], bool) {
+	item, ok := cache.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	if cache.options.TTL > 0 && cache.isExpired(item, cache.options.Clock.Now()) {
+		cache.evict(item, Evict_Expired)
+		return nil, false
+	}
+
+	return item, true
+}
+
+func (cache *Cache[K, V]) isExpired(item *entry[K, V], now time.Time) bool {
+	return !item.expires.IsZero() && !now.Before(item.expires)
+}
+
+func (cache *Cache[K, V]) shrink() {
+	if cache.options.Capacity == 0 {
+		return
+	}
+
+	for cache.weight > cache.options.Capacity {
+		victim, ok := cache.policy.Evict()
+		if !ok {
+			return
+		}
+		cache.drop(cache.entries[victim], Evict_Capacity)
+	}
+}
+
+// Remove entry from policy as well as cache, for evictions policy did not pick
+func (cache *Cache[K, V]) evict(item *entry[K, V], reason Evict_Reason) {
+	cache.policy.Remove(item.key)
+	cache.drop(item, reason)
+}
+
+// Remove entry policy no longer holds
+func (cache *Cache[K, V]) drop(item *entry[K, V], reason Evict_Reason) {
+	delete(cache.entries, item.key)
+	cache.weight -= item.weight
+	cache.report(item, reason)
+}
+
+// Count eviction and notify `On_Evict` for entry no longer in cache
+func (cache *Cache[K, V]) report(item *entry[K, V], reason Evict_Reason) {
+	if reason != Evict_Removed {
+		cache.stats.Evictions++
+	}
+	if cache.options.On_Evict != nil {
+		cache.options.On_Evict(item.key, item.value, reason)
+	}
+}
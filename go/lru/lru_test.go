@@ -0,0 +1,229 @@
+package lru
+
+import (
+	"testing"
+	"time"
+)
+
+type fake_clock struct {
+	now time.Time
+}
+
+func (clock *fake_clock) Now() time.Time {
+	return clock.now
+}
+
+type eviction struct {
+	key    string
+	value  int
+	reason Evict_Reason
+}
+
+func Test_Get_and_Peek_on_empty_cache(t *testing.T) {
+	cache := New_Cache(Options[string, int]{Capacity: 2})
+
+	if _, ok := cache.Get("a"); ok {
+		t.Fatalf(`Expected miss on empty cache`)
+	}
+	if _, ok := cache.Peek("a"); ok {
+		t.Fatalf(`Expected miss on empty cache`)
+	}
+	if cache.Remove("a") {
+		t.Fatalf(`Expected Remove to report missing key`)
+	}
+	if stats := cache.Stats(); stats != (Stats{Misses: 1}) {
+		t.Fatalf(`Expected one miss, Peek not counted, but got %+v`, stats)
+	}
+}
+
+func Test_Put_evicts_least_recently_used(t *testing.T) {
+	evicted := []eviction{}
+	cache := New_Cache(Options[string, int]{
+		Capacity: 2,
+		On_Evict: func(key string, value int, reason Evict_Reason) {
+			evicted = append(evicted, eviction{key, value, reason})
+		},
+	})
+
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Get("a")
+	cache.Put("c", 3)
+
+	if _, ok := cache.Peek("b"); ok {
+		t.Fatalf(`Expected "b" evicted`)
+	}
+	if len(evicted) != 1 || evicted[0] != (eviction{"b", 2, Evict_Capacity}) {
+		t.Fatalf(`Expected "b" evicted for capacity but got %v`, evicted)
+	}
+
+	// Peek must not refresh recency, so "a" is still oldest
+	cache.Peek("a")
+	cache.Put("d", 4)
+	if _, ok := cache.Peek("a"); ok {
+		t.Fatalf(`Expected "a" evicted after Peek`)
+	}
+
+	if cache.Length() != 2 {
+		t.Fatalf(`Expected length 2 but got %v`, cache.Length())
+	}
+	if stats := cache.Stats(); stats != (Stats{Hits: 1, Evictions: 2}) {
+		t.Fatalf(`Expected 1 hit and 2 evictions but got %+v`, stats)
+	}
+}
+
+func Test_Put_overwrites_and_refreshes(t *testing.T) {
+	evicted := 0
+	cache := New_Cache(Options[string, int]{
+		Capacity: 2,
+		On_Evict: func(string, int, Evict_Reason) { evicted++ },
+	})
+
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Put("a", 10)
+	cache.Put("c", 3)
+
+	if value, ok := cache.Get("a"); !ok || value != 10 {
+		t.Fatalf(`Expected "a" to hold 10 but got %v, %v`, value, ok)
+	}
+	if _, ok := cache.Peek("b"); ok {
+		t.Fatalf(`Expected "b" evicted`)
+	}
+	if evicted != 1 {
+		t.Fatalf(`Expected overwrite not to call On_Evict, got %v calls`, evicted)
+	}
+}
+
+func Test_Remove_reports_reason(t *testing.T) {
+	evicted := []eviction{}
+	cache := New_Cache(Options[string, int]{
+		On_Evict: func(key string, value int, reason Evict_Reason) {
+			evicted = append(evicted, eviction{key, value, reason})
+		},
+	})
+
+	cache.Put("a", 1)
+	if !cache.Remove("a") || cache.Length() != 0 {
+		t.Fatalf(`Expected "a" removed`)
+	}
+	if len(evicted) != 1 || evicted[0].reason != Evict_Removed {
+		t.Fatalf(`Expected Evict_Removed but got %v`, evicted)
+	}
+	if cache.Stats().Evictions != 0 {
+		t.Fatalf(`Expected explicit removal not counted as eviction`)
+	}
+}
+
+func Test_Weigher_bounds_total_weight(t *testing.T) {
+	evicted := []eviction{}
+	cache := New_Cache(Options[string, int]{
+		Capacity: 10,
+		Weigher:  func(key string, value int) uint { return uint(value) },
+		On_Evict: func(key string, value int, reason Evict_Reason) {
+			evicted = append(evicted, eviction{key, value, reason})
+		},
+	})
+
+	cache.Put("a", 4)
+	cache.Put("b", 4)
+	cache.Put("c", 4)
+
+	if cache.Length() != 2 || cache.Weight() != 8 {
+		t.Fatalf(`Expected 2 entries weighing 8 but got %v weighing %v`, cache.Length(), cache.Weight())
+	}
+
+	cache.Put("b", 1)
+	if cache.Weight() != 5 {
+		t.Fatalf(`Expected weight 5 after overwrite but got %v`, cache.Weight())
+	}
+
+	evicted = evicted[:0]
+	cache.Put("huge", 11)
+	if _, ok := cache.Peek("huge"); ok {
+		t.Fatalf(`Expected entry heavier than capacity to be evicted`)
+	}
+	if len(evicted) != 1 || evicted[0] != (eviction{"huge", 11, Evict_Capacity}) {
+		t.Fatalf(`Expected only "huge" evicted but got %v`, evicted)
+	}
+	if cache.Length() != 2 || cache.Weight() != 5 {
+		t.Fatalf(`Expected other entries untouched at 2 weighing 5 but got %v weighing %v`, cache.Length(), cache.Weight())
+	}
+}
+
+func Test_TTL_expires_entries(t *testing.T) {
+	clock := &fake_clock{now: time.Unix(0, 0)}
+	evicted := []eviction{}
+	cache := New_Cache(Options[string, int]{
+		TTL:   time.Minute,
+		Clock: clock,
+		On_Evict: func(key string, value int, reason Evict_Reason) {
+			evicted = append(evicted, eviction{key, value, reason})
+		},
+	})
+
+	cache.Put("a", 1)
+	clock.now = clock.now.Add(30 * time.Second)
+	cache.Put("b", 2)
+
+	if _, ok := cache.Get("a"); !ok {
+		t.Fatalf(`Expected "a" alive before TTL`)
+	}
+
+	clock.now = clock.now.Add(30 * time.Second)
+	if _, ok := cache.Get("a"); ok {
+		t.Fatalf(`Expected "a" expired, reads must not extend TTL`)
+	}
+	if _, ok := cache.Peek("b"); !ok {
+		t.Fatalf(`Expected "b" alive`)
+	}
+	if len(evicted) != 1 || evicted[0] != (eviction{"a", 1, Evict_Expired}) {
+		t.Fatalf(`Expected "a" expired but got %v`, evicted)
+	}
+	if stats := cache.Stats(); stats != (Stats{Hits: 1, Misses: 1, Evictions: 1}) {
+		t.Fatalf(`Expected 1 hit, 1 miss and 1 eviction but got %+v`, stats)
+	}
+}
+
+func Test_Peek_leaves_expired_entries_alone(t *testing.T) {
+	clock := &fake_clock{now: time.Unix(0, 0)}
+	cache := New_Cache(Options[string, int]{TTL: time.Minute, Clock: clock})
+
+	cache.Put("a", 1)
+	clock.now = clock.now.Add(time.Minute)
+
+	if _, ok := cache.Peek("a"); ok {
+		t.Fatalf(`Expected expired "a" to read as missing`)
+	}
+	if cache.Length() != 1 || cache.Stats() != (Stats{}) {
+		t.Fatalf(`Expected Peek to keep "a" and leave stats alone but got %v and %+v`, cache.Length(), cache.Stats())
+	}
+	if removed := cache.Remove_Expired(); removed != 1 {
+		t.Fatalf(`Expected Remove_Expired to drop "a" but got %v`, removed)
+	}
+}
+
+func Test_Remove_Expired_sweeps_every_entry(t *testing.T) {
+	clock := &fake_clock{now: time.Unix(0, 0)}
+	cache := New_Cache(Options[int, int]{TTL: time.Minute, Clock: clock})
+
+	for i := 0; i < 6; i++ {
+		if i == 3 {
+			clock.now = clock.now.Add(time.Minute)
+		}
+		cache.Put(i, i)
+	}
+	cache.Get(4)
+
+	if removed := cache.Remove_Expired(); removed != 3 {
+		t.Fatalf(`Expected 3 expired but got %v`, removed)
+	}
+	if cache.Length() != 3 {
+		t.Fatalf(`Expected 3 left but got %v`, cache.Length())
+	}
+	for i := 3; i < 6; i++ {
+		if _, ok := cache.Peek(i); !ok {
+			t.Fatalf(`Expected %v alive`, i)
+		}
+	}
+}
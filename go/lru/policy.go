@@ -0,0 +1,118 @@
+package lru
+
+import doubly_linked_list "doubly-linked-list"
+
+// Decides which key leaves when a new key is admitted or `Cache` is over
+// `Capacity`, values live in `Cache`, policies only order keys
+//
+// Every policy here is built on `doubly_linked_list.List` with a map of node
+// handles, so each operation is `O(1)`
+type Policy[K comparable] interface {
+	// Key is resident and was read or overwritten
+	Hit(key K)
+
+	// Key is not resident and is being inserted, returns resident key that
+	// had to leave to make room, if any
+	Admit(key K) (victim K, evicted bool)
+
+	// Key is resident and is being dropped by caller
+	Remove(key K)
+
+	// Drop and return the resident key that should leave next, regardless of
+	// capacity, evicted is false only when no key is resident
+	Evict() (victim K, evicted bool)
+
+	// Number of resident keys
+	Length() uint
+}
+
+// List of unique keys, with node handles for `O(1)` access by key, head is
+// most recent
+type key_list[K comparable] struct {
+	list  doubly_linked_list.List[K]
+	nodes map[K]*doubly_linked_list.Node[K]
+}
+
+func newKeyList[K comparable]() *key_list[K] {
+	return &key_list[K]{
+		nodes: map[K]*doubly_linked_list.Node[K]{},
+	}
+}
+
+func (keys *key_list[K]) length() uint {
+	return keys.list.Length
+}
+
+func (keys *key_list[K]) contains(key K) bool {
+	_, ok := keys.nodes[key]
+	return ok
+}
+
+func (keys *key_list[K]) pushFront(key K) {
+	keys.nodes[key] = keys.list.Prepend(key)
+}
+
+func (keys *key_list[K]) moveToFront(key K) {
+	keys.list.Move_To_Front(keys.nodes[key])
+}
+
+func (keys *key_list[K]) remove(key K) bool {
+	node, ok := keys.nodes[key]
+	if !ok {
+		return false
+	}
+	keys.list.Remove_Node(node)
+	delete(keys.nodes, key)
+	return true
+}
+
+func (keys *key_list[K]) popBack() (K, bool) {
+	node := keys.list.Cursor_Back().Node()
+	if node == nil {
+		var result K
+		return result, false
+	}
+	keys.remove(node.Value())
+	return node.Value(), true
+}
+
+// Plain least recently used ordering, the default for `Cache`
+type LRU_Policy[K comparable] struct {
+	capacity uint
+	keys     *key_list[K]
+}
+
+// Capacity counts keys, 0 means unbounded and leaves eviction to `Cache`
+func New_LRU_Policy[K comparable](capacity uint) *LRU_Policy[K] {
+	return &LRU_Policy[K]{
+		capacity: capacity,
+		keys:     newKeyList[K](),
+	}
+}
+
+func (policy *LRU_Policy[K]) Hit(key K) {
+	policy.keys.moveToFront(key)
+}
+
+func (policy *LRU_Policy[K]) Admit(key K) (K, bool) {
+	var victim K
+	evicted := false
+	if policy.capacity > 0 && policy.keys.length() >= policy.capacity {
+		victim, evicted = policy.keys.popBack()
+	}
+
+	policy.keys.pushFront(key)
+	return victim, evicted
+}
+
+func (policy *LRU_Policy[K]) Remove(key K) {
+	policy.keys.remove(key)
+}
+
+func (policy *LRU_Policy[K]) Evict() (K, bool) {
+	return policy.keys.popBack()
+}
+
+func (policy *LRU_Policy[K]) Length() uint {
+	return policy.keys.length()
+}
@@ -0,0 +1,255 @@
+package lru
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func allPolicies(capacity uint) map[string]Policy[int] {
+	return map[string]Policy[int]{
+		"lru": New_LRU_Policy[int](capacity),
+		"lfu": New_LFU_Policy[int](capacity),
+		"arc": New_ARC_Policy[int](capacity),
+		"2q":  New_Two_Q_Policy[int](capacity),
+	}
+}
+
+// Drive a policy with random traffic and check it never loses track of which
+// keys are resident, compared against a plain set
+func Test_policies_keep_resident_set_consistent(t *testing.T) {
+	for name, policy := range allPolicies(16) {
+		t.Run(name, func(t *testing.T) {
+			random := rand.New(rand.NewSource(1))
+			resident := map[int]bool{}
+
+			for i := 0; i < 20000; i++ {
+				key := random.Intn(64)
+				if random.Intn(4) == 0 {
+					key = random.Intn(8)
+				}
+
+				switch {
+				case random.Intn(50) == 0:
+					victim, evicted := policy.Evict()
+					if evicted != (len(resident) > 0) || (evicted && !resident[victim]) {
+						t.Fatalf(`Step %v evicted %v which was not resident`, i, victim)
+					}
+					delete(resident, victim)
+				case resident[key] && random.Intn(10) == 0:
+					policy.Remove(key)
+					delete(resident, key)
+				case resident[key]:
+					policy.Hit(key)
+				default:
+					victim, evicted := policy.Admit(key)
+					if evicted {
+						if !resident[victim] || victim == key {
+							t.Fatalf(`Step %v evicted %v which was not resident`, i, victim)
+						}
+						delete(resident, victim)
+					}
+					resident[key] = true
+				}
+
+				if policy.Length() != uint(len(resident)) {
+					t.Fatalf(`Step %v expected length %v but got %v`, i, len(resident), policy.Length())
+				}
+				if policy.Length() > 16 {
+					t.Fatalf(`Step %v exceeded capacity with %v keys`, i, policy.Length())
+				}
+			}
+		})
+	}
+}
+
+func Test_policies_only_evict_when_full(t *testing.T) {
+	for name, policy := range allPolicies(4) {
+		for key := 0; key < 4; key++ {
+			if victim, evicted := policy.Admit(key); evicted {
+				t.Fatalf(`%v evicted %v before reaching capacity`, name, victim)
+			}
+		}
+		policy.Remove(2)
+		if victim, evicted := policy.Admit(9); evicted {
+			t.Fatalf(`%v evicted %v after a removal left room`, name, victim)
+		}
+		if _, evicted := policy.Admit(10); !evicted {
+			t.Fatalf(`%v did not evict once full`, name)
+		}
+	}
+}
+
+func Test_LRU_Policy_evicts_least_recent(t *testing.T) {
+	policy := New_LRU_Policy[string](2)
+	policy.Admit("a")
+	policy.Admit("b")
+	policy.Hit("a")
+
+	if victim, _ := policy.Admit("c"); victim != "b" {
+		t.Fatalf(`Expected "b" evicted but got %v`, victim)
+	}
+}
+
+func Test_LFU_Policy_evicts_least_frequent_then_least_recent(t *testing.T) {
+	policy := New_LFU_Policy[string](3)
+	policy.Admit("a")
+	policy.Admit("b")
+	policy.Admit("c")
+	policy.Hit("a")
+	policy.Hit("a")
+	policy.Hit("c")
+
+	// "b" was read least often
+	if victim, _ := policy.Admit("d"); victim != "b" {
+		t.Fatalf(`Expected "b" evicted but got %v`, victim)
+	}
+
+	// "d" has count 1, fewer than "c"
+	if victim, _ := policy.Admit("e"); victim != "d" {
+		t.Fatalf(`Expected "d" evicted but got %v`, victim)
+	}
+
+	// "c" and "e" now both have count 2, "c" was read longer ago
+	policy.Hit("e")
+	if victim, _ := policy.Admit("f"); victim != "c" {
+		t.Fatalf(`Expected older "c" to lose tie with "e" but got %v`, victim)
+	}
+}
+
+// Keys read twice must survive repeated scans of keys read once, which flush
+// plain LRU every round
+func Test_scan_resistant_policies_keep_hot_keys(t *testing.T) {
+	policies := map[string]Policy[int]{
+		"lfu": New_LFU_Policy[int](10),
+		"arc": New_ARC_Policy[int](10),
+		"2q":  New_Two_Q_Policy[int](10),
+	}
+
+	for name, policy := range policies {
+		cache := New_Cache(Options[int, bool]{
+			Policy: func() Policy[int] { return policy },
+		})
+		touch := func(key int) {
+			if _, ok := cache.Get(key); !ok {
+				cache.Put(key, true)
+			}
+		}
+
+		for round := 0; round < 5; round++ {
+			for pass := 0; pass < 2; pass++ {
+				for key := 0; key < 6; key++ {
+					touch(key)
+				}
+			}
+			for key := 0; key < 8; key++ {
+				touch(1000 + round*100 + key)
+			}
+		}
+
+		for key := 0; key < 6; key++ {
+			if _, ok := cache.Peek(key); !ok {
+				t.Fatalf(`%v lost hot key %v to a scan`, name, key)
+			}
+		}
+	}
+}
+
+func Test_Cache_evicts_on_policy_admission(t *testing.T) {
+	cache := New_Cache(Options[string, int]{
+		Policy: func() Policy[string] { return New_LRU_Policy[string](2) },
+	})
+
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Put("a", 10)
+	cache.Put("c", 3)
+
+	if _, ok := cache.Get("b"); ok {
+		t.Fatalf(`Expected "b" evicted since overwrite counts as use of "a"`)
+	}
+	if value, ok := cache.Get("a"); !ok || value != 10 {
+		t.Fatalf(`Expected 10 but got %v, %v`, value, ok)
+	}
+	if !cache.Remove("a") || cache.Remove("a") || cache.Length() != 1 {
+		t.Fatalf(`Expected "a" removed once`)
+	}
+	if stats := cache.Stats(); stats != (Stats{Hits: 1, Misses: 1, Evictions: 1}) {
+		t.Fatalf(`Expected 1 hit, 1 miss and 1 eviction but got %+v`, stats)
+	}
+}
+
+func Test_Cache_weighs_and_reports_evictions_for_any_policy(t *testing.T) {
+	for name := range allPolicies(1) {
+		evicted := []eviction{}
+		cache := New_Cache(Options[int, int]{
+			Capacity: 6,
+			Weigher:  func(key int, value int) uint { return uint(value) },
+			Policy:   func() Policy[int] { return allPolicies(100)[name] },
+			On_Evict: func(key int, value int, reason Evict_Reason) {
+				evicted = append(evicted, eviction{fmt.Sprint(key), value, reason})
+			},
+		})
+
+		cache.Put(1, 2)
+		cache.Put(2, 2)
+		cache.Put(3, 2)
+		cache.Put(4, 3)
+
+		if cache.Weight() > 6 {
+			t.Fatalf(`%v expected weight within 6 but got %v`, name, cache.Weight())
+		}
+		if len(evicted) == 0 || evicted[0].reason != Evict_Capacity {
+			t.Fatalf(`%v expected capacity evictions but got %v`, name, evicted)
+		}
+		if cache.policy.Length() != cache.Length() {
+			t.Fatalf(`%v policy holds %v keys but cache %v`, name, cache.policy.Length(), cache.Length())
+		}
+	}
+}
+
+func Test_ARC_Policy_bounds_ghosts_when_Cache_shrinks_by_weight(t *testing.T) {
+	policy := New_ARC_Policy[int](8)
+	cache := New_Cache(Options[int, int]{
+		Capacity: 10,
+		Weigher:  func(key int, value int) uint { return uint(value) },
+		Policy:   func() Policy[int] { return policy },
+	})
+
+	random := rand.New(rand.NewSource(1))
+	for i := 0; i < 2000; i++ {
+		key := random.Intn(100)
+		if _, ok := cache.Get(key); !ok {
+			cache.Put(key, 1+random.Intn(5))
+		}
+
+		if ghosts := policy.b1.length() + policy.b2.length(); ghosts > 8 {
+			t.Fatalf(`Step %v holds %v ghosts, more than capacity 8`, i, ghosts)
+		}
+		if cache.Weight() > 10 || policy.Length() != cache.Length() {
+			t.Fatalf(`Step %v weighs %v with %v policy keys for %v entries`, i, cache.Weight(), policy.Length(), cache.Length())
+		}
+	}
+}
+
+func Benchmark_policies(b *testing.B) {
+	random := rand.New(rand.NewSource(1))
+	keys := make([]int, 1<<16)
+	for i := range keys {
+		keys[i] = int(random.ExpFloat64() * 1000)
+	}
+
+	for name, policy := range allPolicies(1024) {
+		b.Run(name, func(b *testing.B) {
+			cache := New_Cache(Options[int, int]{
+				Policy: func() Policy[int] { return policy },
+			})
+			for i := 0; i < b.N; i++ {
+				key := keys[i%len(keys)]
+				if _, ok := cache.Get(key); !ok {
+					cache.Put(key, key)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package lru
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+type Replay_Result struct {
+	Requests uint64
+	Hits     uint64
+	Misses   uint64
+}
+
+func (result Replay_Result) Hit_Ratio() float64 {
+	if result.Requests == 0 {
+		return 0
+	}
+	return float64(result.Hits) / float64(result.Requests)
+}
+
+func (result Replay_Result) String() string {
+	return fmt.Sprintf("%d requests, %d hits, %d misses, %.2f%% hit ratio", result.Requests, result.Hits, result.Misses, 100*result.Hit_Ratio())
+}
+
+/**
+ * Stream access log through every policy at once and report hit ratios,
+ * each request is a read that inserts the key on a miss
+ *
+ * Log has one request per line, the key is the first whitespace separated
+ * field so extra columns such as timestamps are ignored, blank lines and
+ * lines starting with `#` are skipped
+ *
+ * ## Example
+ *
+ *	results, err := Replay(file, map[string]Policy[string]{
+ *		"lru": New_LRU_Policy[string](1000),
+ *		"lfu": New_LFU_Policy[string](1000),
+ *		"arc": New_ARC_Policy[string](1000),
+ *		"2q":  New_Two_Q_Policy[string](1000),
+ *	})
+ *	for name, result := range results {
+ *		fmt.Println(name, result)
+ *	}
+ */
+func Replay(reader io.Reader, policies map[string]Policy[string]) (map[string]Replay_Result, error) {
+	caches := map[string]*Cache[string, struct{}]{}
+	results := map[string]Replay_Result{}
+	for name, policy := range policies {
+		caches[name] = New_Cache(Options[string, struct{}]{
+			Policy: func() Policy[string] { return policy },
+		})
+	}
+
+	scanner := bufio.NewScanner(reader)
+	line := 0
+	for scanner.Scan() {
+		line++
+
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		key := fields[0]
+
+		for name, cache := range caches {
+			result := results[name]
+			result.Requests++
+			if _, ok := cache.Get(key); ok {
+				result.Hits++
+			} else {
+				result.Misses++
+				cache.Put(key, struct{}{})
+			}
+			results[name] = result
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return results, fmt.Errorf("Reading trace after line %d: %w", line, err)
+	}
+
+	return results, nil
+}
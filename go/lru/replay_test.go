@@ -0,0 +1,83 @@
+package lru
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func Test_Replay_counts_hits_and_misses(t *testing.T) {
+	trace := strings.Join([]string{
+		"# key timestamp",
+		"a 1",
+		"b 2",
+		"",
+		"a 3",
+		"c 4",
+		"b 5",
+		"a 6",
+	}, "\n")
+
+	results, err := Replay(strings.NewReader(trace), map[string]Policy[string]{
+		"lru": New_LRU_Policy[string](2),
+		"big": New_LRU_Policy[string](10),
+	})
+	if err != nil {
+		t.Fatalf(`Unexpected error %v`, err)
+	}
+
+	// a b a(hit) c(evicts b) b(miss, evicts a) a(miss)
+	if result := results["lru"]; result != (Replay_Result{Requests: 6, Hits: 1, Misses: 5}) {
+		t.Fatalf(`Unexpected lru result %+v`, result)
+	}
+	if result := results["big"]; result != (Replay_Result{Requests: 6, Hits: 3, Misses: 3}) || result.Hit_Ratio() != 0.5 {
+		t.Fatalf(`Unexpected big result %+v`, result)
+	}
+	if text := results["big"].String(); text != "6 requests, 3 hits, 3 misses, 50.00% hit ratio" {
+		t.Fatalf(`Unexpected text %q`, text)
+	}
+}
+
+func Test_Replay_scan_heavy_trace_favours_scan_resistant_policies(t *testing.T) {
+	lines := []string{}
+	for round := 0; round < 50; round++ {
+		for pass := 0; pass < 2; pass++ {
+			for key := 0; key < 6; key++ {
+				lines = append(lines, fmt.Sprint("hot-", key))
+			}
+		}
+		for key := 0; key < 8; key++ {
+			lines = append(lines, fmt.Sprint("scan-", round, "-", key))
+		}
+	}
+
+	results, err := Replay(strings.NewReader(strings.Join(lines, "\n")), map[string]Policy[string]{
+		"lru": New_LRU_Policy[string](10),
+		"lfu": New_LFU_Policy[string](10),
+		"arc": New_ARC_Policy[string](10),
+		"2q":  New_Two_Q_Policy[string](10),
+	})
+	if err != nil {
+		t.Fatalf(`Unexpected error %v`, err)
+	}
+
+	// LRU only hits on the second pass of each round
+	if hits := results["lru"].Hits; hits != 300 {
+		t.Fatalf(`Expected lru to hit 300 times but got %v`, results["lru"])
+	}
+	for _, name := range []string{"lfu", "arc", "2q"} {
+		if results[name].Hit_Ratio() <= 1.5*results["lru"].Hit_Ratio() {
+			t.Fatalf(`Expected %v to clearly beat lru, got %v against %v`, name, results[name], results["lru"])
+		}
+	}
+}
+
+func Test_Replay_reports_reader_error(t *testing.T) {
+	reader := iotest.DataErrReader(iotest.ErrReader(errors.New("boom")))
+
+	if _, err := Replay(reader, map[string]Policy[string]{}); err == nil || !strings.Contains(err.Error(), "boom") {
+		t.Fatalf(`Expected reader error but got %v`, err)
+	}
+}
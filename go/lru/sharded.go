@@ -0,0 +1,137 @@
+package lru
+
+import (
+	"hash/maphash"
+	"sync"
+)
+
+type shard[K comparable, V any] struct {
+	mutex sync.Mutex
+	cache *Cache[K, V]
+}
+
+// Concurrency safe cache splitting keys across independently locked `Cache`
+// shards, so goroutines touching different keys rarely contend
+//
+// ## Example
+//
+//	cache := New_Sharded_Cache(16, Options[string, []byte]{Capacity: 1 << 20})
+//	go cache.Put("a", data)
+//	value, ok := cache.Get("a")
+//
+// @notes
+//
+// - `Capacity` is split evenly, so recency is only exact within a shard
+// - `Policy` builds one policy per shard, any key capacity it sets applies to
+// each shard
+// - `On_Evict` runs while its shard is locked and must not call back into
+// the cache
+type Sharded_Cache[K comparable, V any] struct {
+	seed   maphash.Seed
+	shards []*shard[K, V]
+}
+
+func New_Sharded_Cache[K comparable, V any](shards uint, options Options[K, V]) *Sharded_Cache[K, V] {
+	if shards == 0 {
+		shards = 1
+	}
+
+	if options.Capacity > 0 {
+		options.Capacity = (options.Capacity + shards - 1) / shards
+	}
+
+	cache := &Sharded_Cache[K, V]{
+		seed:   maphash.MakeSeed(),
+		shards: make([]*shard[K, V], shards),
+	}
+	for i := range cache.shards {
+		cache.shards[i] = &shard[K, V]{cache: New_Cache(options)}
+	}
+
+	return cache
+}
+
+func (cache *Sharded_Cache[K, V]) Get(key K) (V, bool) {
+	shard := cache.shardFor(key)
+	shard.mutex.Lock()
+	defer shard.mutex.Unlock()
+	return shard.cache.Get(key)
+}
+
+func (cache *Sharded_Cache[K, V]) Peek(key K) (V, bool) {
+	shard := cache.shardFor(key)
+	shard.mutex.Lock()
+	defer shard.mutex.Unlock()
+	return shard.cache.Peek(key)
+}
+
+func (cache *Sharded_Cache[K, V]) Put(key K, value V) {
+	shard := cache.shardFor(key)
+	shard.mutex.Lock()
+	defer shard.mutex.Unlock()
+	shard.cache.Put(key, value)
+}
+
+func (cache *Sharded_Cache[K, V]) Remove(key K) bool {
+	shard := cache.shardFor(key)
+	shard.mutex.Lock()
+	defer shard.mutex.Unlock()
+	return shard.cache.Remove(key)
+}
+
+func (cache *Sharded_Cache[K, V]) Remove_Expired() uint {
+	removed := uint(0)
+	cache.each(func(shard *Cache[K, V]) {
+		removed += shard.Remove_Expired()
+	})
+	return removed
+}
+
+/**
+ * Returns number of entries summed over shards
+ */
+func (cache *Sharded_Cache[K, V]) Length() uint {
+	length := uint(0)
+	cache.each(func(shard *Cache[K, V]) {
+		length += shard.Length()
+	})
+	return length
+}
+
+/**
+ * Returns total weight summed over shards
+ */
+func (cache *Sharded_Cache[K, V]) Weight() uint {
+	weight := uint(0)
+	cache.each(func(shard *Cache[K, V]) {
+		weight += shard.Weight()
+	})
+	return weight
+}
+
+/**
+ * Returns counters summed over shards
+ */
+func (cache *Sharded_Cache[K, V]) Stats() Stats {
+	stats := Stats{}
+	cache.each(func(shard *Cache[K, V]) {
+		shard_stats := shard.Stats()
+		stats.Hits += shard_stats.Hits
+		stats.Misses += shard_stats.Misses
+		stats.Evictions += shard_stats.Evictions
+	})
+	return stats
+}
+
+func (cache *Sharded_Cache[K, V]) shardFor(key K) *shard[K, V] {
+	return cache.shards[maphash.Comparable(cache.seed, key)%uint64(len(cache.shards))]
+}
+
+// Visit shards one at a time, holding only that shard's lock
+func (cache *Sharded_Cache[K, V]) each(visit func(*Cache[K, V])) {
+	for _, shard := range cache.shards {
+		shard.mutex.Lock()
+		visit(shard.cache)
+		shard.mutex.Unlock()
+	}
+}
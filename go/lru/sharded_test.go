@@ -0,0 +1,78 @@
+package lru
+
+import (
+	"sync"
+	"testing"
+)
+
+func Test_Sharded_Cache_basic_operations(t *testing.T) {
+	cache := New_Sharded_Cache(4, Options[int, int]{})
+
+	for i := 0; i < 100; i++ {
+		cache.Put(i, i*i)
+	}
+	if cache.Length() != 100 {
+		t.Fatalf(`Expected 100 entries but got %v`, cache.Length())
+	}
+
+	if value, ok := cache.Get(7); !ok || value != 49 {
+		t.Fatalf(`Expected 49 but got %v, %v`, value, ok)
+	}
+	if _, ok := cache.Get(1000); ok {
+		t.Fatalf(`Expected miss`)
+	}
+	if value, ok := cache.Peek(9); !ok || value != 81 {
+		t.Fatalf(`Expected 81 but got %v, %v`, value, ok)
+	}
+	if !cache.Remove(7) || cache.Remove(7) {
+		t.Fatalf(`Expected Remove to succeed once`)
+	}
+
+	if stats := cache.Stats(); stats.Hits != 1 || stats.Misses != 1 {
+		t.Fatalf(`Expected 1 hit and 1 miss but got %+v`, stats)
+	}
+	if cache.Weight() != 99 {
+		t.Fatalf(`Expected weight 99 but got %v`, cache.Weight())
+	}
+}
+
+func Test_Sharded_Cache_splits_capacity(t *testing.T) {
+	cache := New_Sharded_Cache(4, Options[int, int]{Capacity: 10})
+
+	for i := 0; i < 1000; i++ {
+		cache.Put(i, i)
+	}
+
+	// Each of 4 shards holds at most ceil(10 / 4)
+	if length := cache.Length(); length > 12 {
+		t.Fatalf(`Expected at most 12 entries but got %v`, length)
+	}
+	if evictions := cache.Stats().Evictions; uint(evictions)+cache.Length() != 1000 {
+		t.Fatalf(`Expected evictions and entries to total 1000 but got %v`, evictions)
+	}
+}
+
+func Test_Sharded_Cache_concurrent_use(t *testing.T) {
+	cache := New_Sharded_Cache(8, Options[int, int]{Capacity: 64})
+
+	var wait sync.WaitGroup
+	for worker := 0; worker < 8; worker++ {
+		wait.Add(1)
+		go func(worker int) {
+			defer wait.Done()
+			for i := 0; i < 1000; i++ {
+				key := (worker*1000 + i) % 128
+				cache.Put(key, key)
+				if value, ok := cache.Get(key); ok && value != key {
+					t.Errorf(`Expected %v but got %v`, key, value)
+				}
+				cache.Remove(key - 1)
+			}
+		}(worker)
+	}
+	wait.Wait()
+
+	if cache.Length() > 64 {
+		t.Fatalf(`Expected at most 64 entries but got %v`, cache.Length())
+	}
+}
@@ -0,0 +1,94 @@
+package lru
+
+// Full 2Q ordering from Johnson and Shasha, new keys wait in FIFO `a1_in`,
+// keys evicted from it are remembered in ghost FIFO `a1_out`, and only a key
+// requested again while remembered is promoted to LRU `am`
+//
+// @notes
+//
+// - `a1_in` is sized to a quarter of capacity and `a1_out` to half, the
+// values suggested by the paper
+// - Hits inside `a1_in` do not reorder it, which is what filters out
+// correlated references from scans
+type Two_Q_Policy[K comparable] struct {
+	capacity uint
+	k_in     uint
+	k_out    uint
+	a1_in    *key_list[K]
+	a1_out   *key_list[K]
+	am       *key_list[K]
+}
+
+// Capacity counts resident keys and is at least 1
+func New_Two_Q_Policy[K comparable](capacity uint) *Two_Q_Policy[K] {
+	capacity = max(capacity, 1)
+
+	return &Two_Q_Policy[K]{
+		capacity: capacity,
+		k_in:     max(capacity/4, 1),
+		k_out:    max(capacity/2, 1),
+		a1_in:    newKeyList[K](),
+		a1_out:   newKeyList[K](),
+		am:       newKeyList[K](),
+	}
+}
+
+func (policy *Two_Q_Policy[K]) Hit(key K) {
+	if policy.am.contains(key) {
+		policy.am.moveToFront(key)
+	}
+}
+
+func (policy *Two_Q_Policy[K]) Admit(key K) (K, bool) {
+	victim, evicted := policy.reclaim()
+
+	if policy.a1_out.remove(key) {
+		policy.am.pushFront(key)
+	} else {
+		policy.a1_in.pushFront(key)
+	}
+
+	return victim, evicted
+}
+
+func (policy *Two_Q_Policy[K]) Remove(key K) {
+	if !policy.a1_in.remove(key) {
+		policy.am.remove(key)
+	}
+}
+
+func (policy *Two_Q_Policy[K]) Evict() (K, bool) {
+	if policy.Length() == 0 {
+		var result K
+		return result, false
+	}
+	return policy.demote()
+}
+
+func (policy *Two_Q_Policy[K]) Length() uint {
+	return policy.a1_in.length() + policy.am.length()
+}
+
+// Free one slot when full, preferring to age `a1_in` into the ghost list
+func (policy *Two_Q_Policy[K]) reclaim() (K, bool) {
+	if policy.Length() < policy.capacity {
+		var result K
+		return result, false
+	}
+	return policy.demote()
+}
+
+// Age `a1_in` into the ghost list while it is over its share, otherwise drop
+// least recent key of `am`
+func (policy *Two_Q_Policy[K]) demote() (K, bool) {
+	if policy.a1_in.length() > policy.k_in || policy.am.length() == 0 {
+		victim, _ := policy.a1_in.popBack()
+		policy.a1_out.pushFront(victim)
+		if policy.a1_out.length() > policy.k_out {
+			policy.a1_out.popBack()
+		}
+		return victim, true
+	}
+
+	return policy.am.popBack()
+}
@@ -0,0 +1,189 @@
+package queue
+
+import (
+	"context"
+	"sync"
+)
+
+/**
+ * Enqueue every value received from channel, returns once channel is closed
+ */
+func (queue *Queue[T]) From_Channel(channel <-chan T) {
+	for item := range channel {
+		queue.Enqueue(item)
+	}
+}
+
+/**
+ * Drain queue, first to last, into returned channel
+ *
+ * Channel is closed once queue is empty or ctx is done, so the background
+ * goroutine always exits even when the reader stops early, unlike the `done`
+ * channel pattern which leaks if the caller forgets to close it
+ *
+ * @note - an item is only removed after it was received, so cancelling never
+ * drops a value, and queue must not be touched until channel is closed
+ *
+ * ## Example
+ *
+ *	ctx, cancel := context.WithCancel(context.Background())
+ *	defer cancel()
+ *	for item := range queue.To_Channel(ctx) {
+ *		fmt.Println(item)
+ *	}
+ */
+func (queue *Queue[T]) To_Channel(ctx context.Context) <-chan T {
+	channel := make(chan T)
+
+	go func() {
+		defer close(channel)
+		for queue.Length > 0 {
+			item, _ := queue.Peek()
+			select {
+			case channel <- item:
+				queue.Deque()
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return channel
+}
+
+/**
+ * Returns channel pair joined by a `Queue`, sends to `in` never wait on
+ * readers of `out` because values are buffered without limit
+ *
+ * Closing `in` flushes remaining values then closes `out`, ctx being done
+ * closes `out` straight away and discards anything still buffered
+ *
+ * @note - after ctx is done values sent to `in` are received and discarded
+ * so senders still never block, the goroutine exits once `in` is closed
+ *
+ * ## Example
+ *
+ *	in, out := New_Unbounded_Channel[int](ctx)
+ *	for i := 0; i < 1000; i++ {
+ *		in <- i // never blocks on a slow reader
+ *	}
+ *	close(in)
+ */
+func New_Unbounded_Channel[T any](ctx context.Context) (chan<- T, <-chan T) {
+	in := make(chan T)
+	out := make(chan T)
+
+	go func() {
+		buffer := Queue[T]{}
+		source := in
+
+		for source != nil || buffer.Length > 0 {
+			// Sending on a nil channel blocks forever, which disables that case
+			// of select while buffer is empty
+			var sink chan T
+			var next T
+			if buffer.Length > 0 {
+				sink = out
+				next, _ = buffer.Peek()
+			}
+
+			select {
+			case item, ok := <-source:
+				if !ok {
+					source = nil
+					continue
+				}
+				buffer.Enqueue(item)
+			case sink <- next:
+				buffer.Deque()
+			case <-ctx.Done():
+				close(out)
+				if source != nil {
+					for range source {
+					}
+				}
+				return
+			}
+		}
+		close(out)
+	}()
+
+	return in, out
+}
+
+/**
+ * Merge many channels into one, closed after every input is closed or ctx is
+ * done
+ */
+func Fan_In[T any](ctx context.Context, channels ...<-chan T) <-chan T {
+	out := make(chan T)
+
+	var group sync.WaitGroup
+	for _, channel := range channels {
+		group.Add(1)
+		go func(channel <-chan T) {
+			defer group.Done()
+			for {
+				select {
+				case item, ok := <-channel:
+					if !ok {
+						return
+					}
+					select {
+					case out <- item:
+					case <-ctx.Done():
+						return
+					}
+				case <-ctx.Done():
+					return
+				}
+			}
+		}(channel)
+	}
+
+	go func() {
+		group.Wait()
+		close(out)
+	}()
+
+	return out
+}
+
+/**
+ * Split one channel across `count` outputs, each value goes to exactly one
+ * output and outputs compete for values, so a slow reader holds up only the
+ * one value it was handed
+ *
+ * Every output is closed once source is closed or ctx is done, a count
+ * below 1 is raised to 1 so source is always read
+ */
+func Fan_Out[T any](ctx context.Context, source <-chan T, count int) []<-chan T {
+	count = max(count, 1)
+	outputs := make([]<-chan T, count)
+
+	for i := range outputs {
+		out := make(chan T)
+		outputs[i] = out
+
+		go func() {
+			defer close(out)
+			for {
+				select {
+				case item, ok := <-source:
+					if !ok {
+						return
+					}
+					select {
+					case out <- item:
+					case <-ctx.Done():
+						return
+					}
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	return outputs
+}
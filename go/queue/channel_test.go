@@ -0,0 +1,229 @@
+package queue
+
+import (
+	"context"
+	"sort"
+	"testing"
+)
+
+func Test_From_Channel_enqueues_until_channel_closes(t *testing.T) {
+	queue := Queue[int]{}
+
+	channel := make(chan int)
+	go func() {
+		defer close(channel)
+		for i := 0; i < 5; i++ {
+			channel <- i
+		}
+	}()
+
+	queue.From_Channel(channel)
+
+	if queue.Length != 5 {
+		t.Fatalf(`Expected queue.Length of 5 but got %v`, queue.Length)
+	}
+
+	peek, err := queue.Peek()
+	if err == nil && peek != 0 {
+		t.Fatalf(`Expected first received value 0 at head but got %v`, peek)
+	}
+}
+
+func Test_To_Channel_drains_first_in_first_out(t *testing.T) {
+	queue := Queue[int]{}
+	for i := 0; i < 5; i++ {
+		queue.Enqueue(i)
+	}
+
+	expected := 0
+	for value := range queue.To_Channel(context.Background()) {
+		if value != expected {
+			t.Fatalf(`Expected value %v but got %v`, expected, value)
+		}
+		expected++
+	}
+
+	if queue.Length != 0 {
+		t.Fatalf(`Expected empty queue but got length %v`, queue.Length)
+	}
+}
+
+func Test_To_Channel_closes_on_cancel_without_dropping_values(t *testing.T) {
+	queue := Queue[int]{}
+	for i := 0; i < 5; i++ {
+		queue.Enqueue(i)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	channel := queue.To_Channel(ctx)
+
+	first := <-channel
+	cancel()
+
+	// Channel closing proves the goroutine returned, a few more values may
+	// slip through while select picks between sending and ctx being done
+	received := []int{first}
+	for value := range channel {
+		received = append(received, value)
+	}
+
+	if queue.Length+uint(len(received)) != 5 {
+		t.Fatalf(`Expected received %v plus remaining %v to account for 5 values`, received, queue.Length)
+	}
+
+	peek, err := queue.Peek()
+	if err == nil && peek != len(received) {
+		t.Fatalf(`Expected head of queue to be %v but got %v`, len(received), peek)
+	}
+}
+
+func Test_Unbounded_Channel_never_blocks_senders(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	in, out := New_Unbounded_Channel[int](ctx)
+
+	// Nothing reads `out` yet, a bounded channel would deadlock here
+	limit := 1000
+	for i := 0; i < limit; i++ {
+		in <- i
+	}
+	close(in)
+
+	expected := 0
+	for value := range out {
+		if value != expected {
+			t.Fatalf(`Expected value %v but got %v`, expected, value)
+		}
+		expected++
+	}
+
+	if expected != limit {
+		t.Fatalf(`Expected %v values but got %v`, limit, expected)
+	}
+}
+
+func Test_Unbounded_Channel_closes_out_when_context_is_done(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	in, out := New_Unbounded_Channel[int](ctx)
+	in <- 1
+	cancel()
+
+	// Closing proves `out` was released even though `in` is still open
+	for range out {
+	}
+
+	// Senders must still get through after cancellation
+	for i := 0; i < 100; i++ {
+		in <- i
+	}
+	close(in)
+}
+
+func Test_Unbounded_Channel_exits_on_cancel_after_in_is_closed(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	in, out := New_Unbounded_Channel[int](ctx)
+	in <- 1
+	in <- 2
+	close(in)
+	<-out
+	cancel()
+
+	for range out {
+	}
+}
+
+func Test_Fan_In_merges_every_value(t *testing.T) {
+	channels := make([]<-chan int, 4)
+	for c := range channels {
+		channel := make(chan int)
+		channels[c] = channel
+		go func(offset int) {
+			defer close(channel)
+			for i := 0; i < 10; i++ {
+				channel <- offset + i
+			}
+		}(c * 10)
+	}
+
+	received := make([]int, 0, 40)
+	for value := range Fan_In(context.Background(), channels...) {
+		received = append(received, value)
+	}
+
+	sort.Ints(received)
+	for i, value := range received {
+		if i != value {
+			t.Fatalf(`Expected value %v but got %v`, i, value)
+		}
+	}
+	if len(received) != 40 {
+		t.Fatalf(`Expected 40 values but got %v`, len(received))
+	}
+}
+
+func Test_Fan_In_closes_when_context_is_done(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// Never closed and never sent on
+	idle := make(chan int)
+	out := Fan_In(ctx, idle)
+	cancel()
+
+	for range out {
+	}
+}
+
+func Test_Fan_Out_raises_count_below_one(t *testing.T) {
+	source := make(chan int, 1)
+	source <- 42
+	close(source)
+
+	outputs := Fan_Out(context.Background(), source, -2)
+	if len(outputs) != 1 {
+		t.Fatalf(`Expected 1 output but got %v`, len(outputs))
+	}
+	if value := <-outputs[0]; value != 42 {
+		t.Fatalf(`Expected value 42 but got %v`, value)
+	}
+}
+
+func Test_Fan_Out_delivers_each_value_exactly_once(t *testing.T) {
+	source := make(chan int)
+	go func() {
+		defer close(source)
+		for i := 0; i < 100; i++ {
+			source <- i
+		}
+	}()
+
+	outputs := Fan_Out(context.Background(), source, 3)
+
+	received := make(chan int)
+	for _, output := range outputs {
+		go func(output <-chan int) {
+			for value := range output {
+				received <- value
+			}
+			received <- -1
+		}(output)
+	}
+
+	seen := make([]int, 100)
+	for closed := 0; closed < len(outputs); {
+		value := <-received
+		if value < 0 {
+			closed++
+			continue
+		}
+		seen[value]++
+	}
+
+	for value, count := range seen {
+		if count != 1 {
+			t.Fatalf(`Expected value %v once but saw it %v times`, value, count)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package queue
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+)
+
+// Items from head to tail, so decoding by enqueueing each in turn rebuilds
+// the same queue
+func (queue Queue[T]) items() []T {
+	items := make([]T, 0, queue.Length)
+	node := queue.head
+	for i := uint(0); i < queue.Length; i++ {
+		items = append(items, node.value)
+		node = node.next
+	}
+	return items
+}
+
+func (queue *Queue[T]) reset(items []T) {
+	*queue = Queue[T]{}
+	for _, item := range items {
+		queue.Enqueue(item)
+	}
+}
+
+/**
+ * Encode as JSON array from head to tail, so `[1,2,3]` dequeues `1` first
+ */
+func (queue Queue[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(queue.items())
+}
+
+/**
+ * Replace contents with JSON array ordered from head to tail
+ */
+func (queue *Queue[T]) UnmarshalJSON(data []byte) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("Queue expects a JSON array: %w", err)
+	}
+
+	items := make([]T, len(raw))
+	for i, message := range raw {
+		if err := json.Unmarshal(message, &items[i]); err != nil {
+			return fmt.Errorf("Cannot decode queue item %d: %w", i, err)
+		}
+	}
+
+	queue.reset(items)
+	return nil
+}
+
+/**
+ * Encode with `encoding/gob` as item count followed by items from head to tail
+ */
+func (queue Queue[T]) MarshalBinary() ([]byte, error) {
+	buffer := bytes.Buffer{}
+	encoder := gob.NewEncoder(&buffer)
+
+	if err := encoder.Encode(queue.Length); err != nil {
+		return nil, err
+	}
+	for i, item := range queue.items() {
+		if err := encoder.Encode(item); err != nil {
+			return nil, fmt.Errorf("Cannot encode queue item %d: %w", i, err)
+		}
+	}
+
+	return buffer.Bytes(), nil
+}
+
+/**
+ * Replace contents with data produced by `MarshalBinary`
+ */
+func (queue *Queue[T]) UnmarshalBinary(data []byte) error {
+	decoder := gob.NewDecoder(bytes.NewReader(data))
+
+	var length uint
+	if err := decoder.Decode(&length); err != nil {
+		return fmt.Errorf("Cannot decode queue length: %w", err)
+	}
+
+	items := []T{}
+	for i := uint(0); i < length; i++ {
+		var item T
+		if err := decoder.Decode(&item); err != nil {
+			return fmt.Errorf("Cannot decode queue item %d of %d: %w", i, length, err)
+		}
+		items = append(items, item)
+	}
+
+	queue.reset(items)
+	return nil
+}
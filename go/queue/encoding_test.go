@@ -0,0 +1,102 @@
+package queue
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func Test_Queue_JSON_round_trip_keeps_FIFO_order(t *testing.T) {
+	queue := Queue[uint]{}
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	queue.Enqueue(3)
+
+	data, err := json.Marshal(queue)
+	if err != nil {
+		t.Fatalf(`Unexpected error %v`, err)
+	}
+	if string(data) != "[1,2,3]" {
+		t.Fatalf(`Expected [1,2,3] but got %s`, data)
+	}
+
+	decoded := Queue[uint]{}
+	decoded.Enqueue(99)
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf(`Unexpected error %v`, err)
+	}
+	if decoded.Length != 3 {
+		t.Fatalf(`Expected length 3 but got %v`, decoded.Length)
+	}
+
+	for _, expected := range []uint{1, 2, 3} {
+		if value, _ := decoded.Deque(); value != expected {
+			t.Fatalf(`Expected %v but got %v`, expected, value)
+		}
+	}
+}
+
+func Test_Queue_JSON_after_partial_drain(t *testing.T) {
+	queue := Queue[string]{}
+	queue.Enqueue("a")
+	queue.Enqueue("b")
+	queue.Deque()
+
+	data, _ := json.Marshal(&queue)
+	if string(data) != `["b"]` {
+		t.Fatalf(`Expected ["b"] but got %s`, data)
+	}
+}
+
+func Test_Queue_UnmarshalJSON_reports_bad_item(t *testing.T) {
+	decoded := Queue[uint]{}
+
+	err := json.Unmarshal([]byte(`[1, 2, -3]`), &decoded)
+	if err == nil || !strings.Contains(err.Error(), "item 2") {
+		t.Fatalf(`Expected error naming item 2 but got %v`, err)
+	}
+
+	err = json.Unmarshal([]byte(`"nope"`), &decoded)
+	if err == nil || !strings.Contains(err.Error(), "JSON array") {
+		t.Fatalf(`Expected error about JSON array but got %v`, err)
+	}
+}
+
+func Test_Queue_binary_round_trip_keeps_FIFO_order(t *testing.T) {
+	queue := Queue[string]{}
+	queue.Enqueue("first")
+	queue.Enqueue("second")
+
+	data, err := queue.MarshalBinary()
+	if err != nil {
+		t.Fatalf(`Unexpected error %v`, err)
+	}
+
+	decoded := Queue[string]{}
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Fatalf(`Unexpected error %v`, err)
+	}
+	if decoded.Length != 2 {
+		t.Fatalf(`Expected length 2 but got %v`, decoded.Length)
+	}
+	if head, _ := decoded.Deque(); head != "first" {
+		t.Fatalf(`Expected "first" at head but got %v`, head)
+	}
+}
+
+func Test_Queue_UnmarshalBinary_reports_bad_data(t *testing.T) {
+	queue := Queue[uint]{}
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	data, _ := queue.MarshalBinary()
+
+	decoded := Queue[uint]{}
+	err := decoded.UnmarshalBinary(data[:len(data)-1])
+	if err == nil || !strings.Contains(err.Error(), "item 1 of 2") {
+		t.Fatalf(`Expected error naming item 1 of 2 but got %v`, err)
+	}
+
+	if err := decoded.UnmarshalBinary(nil); err == nil || !strings.Contains(err.Error(), "length") {
+		t.Fatalf(`Expected error about length but got %v`, err)
+	}
+}
@@ -0,0 +1,50 @@
+package queue
+
+import "iter"
+
+/**
+ * Iterate values from head to tail without removing them
+ *
+ * @note - queue must not be changed while iterating
+ */
+func (queue *Queue[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		node := queue.head
+		for i := uint(0); i < queue.Length; i++ {
+			if !yield(node.value) {
+				return
+			}
+			node = node.next
+		}
+	}
+}
+
+/**
+ * Dequeue and yield values until queue is empty, stopping early leaves the
+ * rest queued
+ *
+ * ## Example
+ *
+ *	for job := range jobs.Drain() {
+ *		run(job) // may enqueue follow up jobs, they are drained too
+ *	}
+ */
+func (queue *Queue[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for queue.Length > 0 {
+			value, _ := queue.Deque()
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+/**
+ * Enqueue every value of sequence in order
+ */
+func (queue *Queue[T]) Enqueue_All(seq iter.Seq[T]) {
+	for value := range seq {
+		queue.Enqueue(value)
+	}
+}
@@ -0,0 +1,45 @@
+package queue
+
+import (
+	"slices"
+	"testing"
+)
+
+func Test_Queue_Values_does_not_consume(t *testing.T) {
+	queue := Queue[int]{}
+	queue.Enqueue_All(slices.Values([]int{1, 2, 3}))
+	queue.Deque()
+	queue.Enqueue(4)
+
+	if values := slices.Collect(queue.Values()); !slices.Equal(values, []int{2, 3, 4}) {
+		t.Fatalf(`Expected [2 3 4] but got %v`, values)
+	}
+	if queue.Length != 3 {
+		t.Fatalf(`Expected length 3 but got %v`, queue.Length)
+	}
+}
+
+func Test_Queue_Drain_consumes_and_stops_early(t *testing.T) {
+	queue := Queue[int]{}
+	queue.Enqueue_All(slices.Values([]int{1, 2, 3, 4}))
+
+	drained := []int{}
+	for value := range queue.Drain() {
+		drained = append(drained, value)
+		if value == 2 {
+			break
+		}
+	}
+	if !slices.Equal(drained, []int{1, 2}) || queue.Length != 2 {
+		t.Fatalf(`Expected [1 2] drained and 2 left but got %v and %v`, drained, queue.Length)
+	}
+
+	for value := range queue.Drain() {
+		if value == 3 {
+			queue.Enqueue(5)
+		}
+	}
+	if queue.Length != 0 {
+		t.Fatalf(`Expected values enqueued while draining to be drained too`)
+	}
+}
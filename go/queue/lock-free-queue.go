@@ -0,0 +1,152 @@
+package queue
+
+import (
+	common_errors "common-errors"
+	"sync/atomic"
+)
+
+// Value is held by pointer so the node can drop it on becoming sentinel, nil
+// once dequeued
+type lock_free_node[T any] struct {
+	value atomic.Pointer[T]
+	next  atomic.Pointer[lock_free_node[T]]
+}
+
+// Michael–Scott non-blocking queue that is safe for many producers and many
+// consumers without any mutex
+//
+// ## Example
+//
+//	queue := Lock_Free_Queue[int]{}
+//	go queue.Enqueue(42)
+//	value, err := queue.Deque()
+//
+// @notes
+//
+// - Zero value is ready to use, the sentinel node is allocated on first use
+// - `head` always points at a sentinel node, first value lives at `head.next`
+// - A node clears its value on becoming sentinel, so a dequeued value is not
+// kept alive by the queue
+// - Must not be copied after first use
+type Lock_Free_Queue[T any] struct {
+	head   atomic.Pointer[lock_free_node[T]]
+	tail   atomic.Pointer[lock_free_node[T]]
+	length atomic.Int64
+}
+
+/**
+ * Appends item to end of queue
+ */
+func (queue *Lock_Free_Queue[T]) Enqueue(item T) {
+	queue.sentinel()
+
+	node := &lock_free_node[T]{}
+	node.value.Store(&item)
+
+	for {
+		tail := queue.tail.Load()
+		next := tail.next.Load()
+
+		// Another goroutine moved tail while we were loading
+		if tail != queue.tail.Load() {
+			continue
+		}
+
+		// Tail is lagging behind, help swing it forward then retry
+		if next != nil {
+			queue.tail.CompareAndSwap(tail, next)
+			continue
+		}
+
+		if tail.next.CompareAndSwap(nil, node) {
+			// Failure is fine, it means some other goroutine helped
+			queue.tail.CompareAndSwap(tail, node)
+			queue.length.Add(1)
+			return
+		}
+	}
+}
+
+/**
+ * Removes and returns first item of queue or an error
+ */
+func (queue *Lock_Free_Queue[T]) Deque() (T, error) {
+	queue.sentinel()
+
+	for {
+		head := queue.head.Load()
+		tail := queue.tail.Load()
+		next := head.next.Load()
+
+		if head != queue.head.Load() {
+			continue
+		}
+
+		if head == tail {
+			if next == nil {
+				var result T
+				return result, &common_errors.Empty_Error{Container: "Queue"}
+			}
+
+			// Tail is lagging behind, help swing it forward then retry
+			queue.tail.CompareAndSwap(tail, next)
+			continue
+		}
+
+		// Value must be read before CAS, afterwards `next` is the sentinel
+		// and its value is cleared by whoever won
+		value := next.value.Load()
+		if queue.head.CompareAndSwap(head, next) {
+			next.value.Store(nil)
+			queue.length.Add(-1)
+			return *value, nil
+		}
+	}
+}
+
+/**
+ * Returns first value of queue without mutation
+ */
+func (queue *Lock_Free_Queue[T]) Peek() (T, error) {
+	queue.sentinel()
+
+	for {
+		next := queue.head.Load().next.Load()
+		if next == nil {
+			var result T
+			return result, &common_errors.Empty_Error{Container: "Queue"}
+		}
+
+		// Nil means `next` was dequeued since loading head, look again
+		if value := next.value.Load(); value != nil {
+			return *value, nil
+		}
+	}
+}
+
+/**
+ * Returns snapshot of number of items in queue
+ *
+ * @note - under concurrent use the count may already be stale when returned
+ */
+func (queue *Lock_Free_Queue[T]) Length() uint {
+	length := queue.length.Load()
+	if length < 0 {
+		// Deque may decrement before a racing Enqueue increments
+		return 0
+	}
+	return uint(length)
+}
+
+// Lazily allocate the shared sentinel node so zero value queues are usable
+//
+// @note - `tail` is checked, not `head`, so no caller continues before both
+// pointers are set
+func (queue *Lock_Free_Queue[T]) sentinel() {
+	if queue.tail.Load() != nil {
+		return
+	}
+
+	queue.head.CompareAndSwap(nil, &lock_free_node[T]{})
+	queue.tail.CompareAndSwap(nil, queue.head.Load())
+}
@@ -0,0 +1,254 @@
+package queue
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	common_errors "common-errors"
+)
+
+func Test_Lock_Free_Queue_Enqueue_increments_length(t *testing.T) {
+	queue := Lock_Free_Queue[uint]{}
+
+	limit := uint(3)
+
+	for i := uint(0); i < limit; i++ {
+		queue.Enqueue(i)
+	}
+
+	if queue.Length() != limit {
+		t.Fatalf(`Expected queue.Length() of %v but got %v`, limit, queue.Length())
+	}
+}
+
+func Test_Lock_Free_Queue_Deque_returns_error_for_empty_queue(t *testing.T) {
+	queue := Lock_Free_Queue[uint]{}
+
+	value, err := queue.Deque()
+	if !errors.Is(err, common_errors.ErrEmpty) {
+		t.Fatalf(`Expected ErrEmpty but got %v`, err)
+	}
+
+	var expected_value uint
+	if value != expected_value {
+		t.Fatalf(`Expected value %v did not match returned value %v`, expected_value, value)
+	}
+}
+
+func Test_Lock_Free_Queue_Deque_decrements_length_and_returns_values(t *testing.T) {
+	queue := Lock_Free_Queue[uint]{}
+
+	limit := uint(3)
+	expected_length := limit
+
+	for i := uint(0); i < limit; i++ {
+		queue.Enqueue(i)
+	}
+
+	for i := uint(0); i < limit; i++ {
+		value, err := queue.Deque()
+		if err != nil {
+			t.Fatalf(`Unexpected error %v`, err)
+		}
+
+		if i != value {
+			t.Fatalf(`Expected queue value of %v but got %v`, i, value)
+		}
+
+		expected_length--
+		if expected_length != queue.Length() {
+			t.Fatalf(`Expected queue length of %v but got %v`, expected_length, queue.Length())
+		}
+	}
+}
+
+func Test_Lock_Free_Queue_Peek_returns_expected_value(t *testing.T) {
+	queue := Lock_Free_Queue[uint]{}
+
+	value := uint(3)
+
+	queue.Enqueue(value)
+
+	peek, err := queue.Peek()
+	if err != nil {
+		t.Fatalf(`Unexpected error %v`, err)
+	}
+
+	if peek != value {
+		t.Fatalf(`Expected queue value of %v but got %v`, value, peek)
+	}
+
+	if queue.Length() != 1 {
+		t.Fatalf(`Expected Peek to not mutate length but got %v`, queue.Length())
+	}
+}
+
+func Test_Lock_Free_Queue_Peek_returns_error_for_empty_queue(t *testing.T) {
+	queue := Lock_Free_Queue[uint]{}
+
+	_, err := queue.Peek()
+	if !errors.Is(err, common_errors.ErrEmpty) {
+		t.Fatalf(`Expected ErrEmpty but got %v`, err)
+	}
+}
+
+func Test_Lock_Free_Queue_sentinel_does_not_retain_dequeued_value(t *testing.T) {
+	queue := Lock_Free_Queue[*[]byte]{}
+
+	buffer := make([]byte, 1<<20)
+	queue.Enqueue(&buffer)
+	queue.Enqueue(nil)
+
+	if _, err := queue.Deque(); err != nil {
+		t.Fatalf(`Unexpected error %v`, err)
+	}
+	if queue.head.Load().value.Load() != nil {
+		t.Fatalf(`Expected sentinel to drop dequeued value`)
+	}
+
+	if value, err := queue.Peek(); err != nil || value != nil {
+		t.Fatalf(`Expected nil value next but got %v, %v`, value, err)
+	}
+}
+
+// Every produced value must be consumed exactly once, run with `go test -race`
+func Test_Lock_Free_Queue_many_producers_and_consumers_lose_and_duplicate_nothing(t *testing.T) {
+	queue := Lock_Free_Queue[int]{}
+
+	producers := 8
+	consumers := 8
+	per_producer := 2000
+	total := producers * per_producer
+
+	seen := make([]int, total)
+	var seen_mutex sync.Mutex
+
+	var consumed sync.WaitGroup
+	remaining := make(chan struct{}, total)
+	for i := 0; i < total; i++ {
+		remaining <- struct{}{}
+	}
+	close(remaining)
+
+	for c := 0; c < consumers; c++ {
+		consumed.Add(1)
+		go func() {
+			defer consumed.Done()
+			// Each receive reserves the right to consume exactly one value
+			for range remaining {
+				for {
+					value, err := queue.Deque()
+					if err != nil {
+						continue
+					}
+					seen_mutex.Lock()
+					seen[value]++
+					seen_mutex.Unlock()
+					break
+				}
+			}
+		}()
+	}
+
+	var produced sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		produced.Add(1)
+		go func(offset int) {
+			defer produced.Done()
+			for i := 0; i < per_producer; i++ {
+				queue.Enqueue(offset + i)
+			}
+		}(p * per_producer)
+	}
+
+	produced.Wait()
+	consumed.Wait()
+
+	for value, count := range seen {
+		if count != 1 {
+			t.Fatalf(`Expected value %v to be consumed once but was consumed %v times`, value, count)
+		}
+	}
+
+	if queue.Length() != 0 {
+		t.Fatalf(`Expected empty queue but got length %v`, queue.Length())
+	}
+}
+
+// Values from any single producer must come out in the order they went in
+func Test_Lock_Free_Queue_preserves_per_producer_order(t *testing.T) {
+	queue := Lock_Free_Queue[[2]int]{}
+
+	producers := 4
+	per_producer := 2000
+
+	var produced sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		produced.Add(1)
+		go func(producer int) {
+			defer produced.Done()
+			for i := 0; i < per_producer; i++ {
+				queue.Enqueue([2]int{producer, i})
+			}
+		}(p)
+	}
+	produced.Wait()
+
+	last := make([]int, producers)
+	for i := range last {
+		last[i] = -1
+	}
+
+	for queue.Length() > 0 {
+		item, err := queue.Deque()
+		if err != nil {
+			t.Fatalf(`Unexpected error %v`, err)
+		}
+
+		producer, sequence := item[0], item[1]
+		if sequence <= last[producer] {
+			t.Fatalf(`Producer %v sequence %v came after %v`, producer, sequence, last[producer])
+		}
+		last[producer] = sequence
+	}
+}
+
+type mutex_queue[T any] struct {
+	mutex sync.Mutex
+	queue Queue[T]
+}
+
+func (wrapped *mutex_queue[T]) Enqueue(item T) {
+	wrapped.mutex.Lock()
+	wrapped.queue.Enqueue(item)
+	wrapped.mutex.Unlock()
+}
+
+func (wrapped *mutex_queue[T]) Deque() (T, error) {
+	wrapped.mutex.Lock()
+	defer wrapped.mutex.Unlock()
+	return wrapped.queue.Deque()
+}
+
+func Benchmark_Lock_Free_Queue_parallel_enqueue_deque(b *testing.B) {
+	queue := Lock_Free_Queue[int]{}
+
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			queue.Enqueue(i)
+			queue.Deque()
+		}
+	})
+}
+
+func Benchmark_Mutex_Queue_parallel_enqueue_deque(b *testing.B) {
+	queue := mutex_queue[int]{}
+
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			queue.Enqueue(i)
+			queue.Deque()
+		}
+	})
+}
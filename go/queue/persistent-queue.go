@@ -0,0 +1,259 @@
+package queue
+
+import (
+	common_errors "common-errors"
+	"sync"
+)
+
+// Immutable singly linked list, used as persistent stack for rear of queues
+type persistent_node[T any] struct {
+	value T
+	next  *persistent_node[T]
+}
+
+// Memoized lazy list cell, a `nil` stream or a stream forcing to `nil` is empty
+//
+// @note - `sync.Once` lets many goroutines share one version of a queue
+// while still evaluating each suspension exactly once
+type stream[T any] struct {
+	once  sync.Once
+	thunk func() *stream_cell[T]
+	cell  *stream_cell[T]
+}
+
+type stream_cell[T any] struct {
+	value T
+	next  *stream[T]
+}
+
+func lazyStream[T any](thunk func() *stream_cell[T]) *stream[T] {
+	return &stream[T]{thunk: thunk}
+}
+
+func (s *stream[T]) force() *stream_cell[T] {
+	if s == nil {
+		return nil
+	}
+
+	s.once.Do(func() {
+		if s.thunk != nil {
+			s.cell = s.thunk()
+			s.thunk = nil
+		}
+	})
+
+	return s.cell
+}
+
+// Lazily concatenate, each forced cell of result forces one cell of `front`
+func appendStream[T any](front, back *stream[T]) *stream[T] {
+	return lazyStream(func() *stream_cell[T] {
+		cell := front.force()
+		if cell == nil {
+			return back.force()
+		}
+		return &stream_cell[T]{
+			value: cell.value,
+			next:  appendStream(cell.next, back),
+		}
+	})
+}
+
+// Monolithic suspension, first force walks all of `node` at once
+func reverseStream[T any](node *persistent_node[T]) *stream[T] {
+	return lazyStream(func() *stream_cell[T] {
+		var result *stream_cell[T]
+		for ; node != nil; node = node.next {
+			result = &stream_cell[T]{
+				value: node.value,
+				next:  &stream[T]{cell: result},
+			}
+		}
+		return result
+	})
+}
+
+// Okasaki banker's queue, `Enqueue` and `Deque` return new versions and leave
+// the receiver untouched so any number of historical versions stay valid
+//
+// ## Example
+//
+//	empty := Persistent_Queue[int]{}
+//	one := empty.Enqueue(1)
+//	two := one.Enqueue(2)
+//	value, rest, err := two.Deque() // 1, queue holding 2, nil
+//	one.Length() == 1               // older version is unchanged
+//
+// @notes
+//
+// - Amortized `O(1)` per operation even when old versions are reused, because
+// the reversal of `rear` is a shared memoized suspension
+// - Invariant is `rear_length <= front_length`
+// - Safe to share between goroutines without locks
+type Persistent_Queue[T any] struct {
+	length       uint
+	front        *stream[T]
+	front_length uint
+	rear         *persistent_node[T]
+}
+
+/**
+ * Returns number of items, kept unexported so `check` can rely on it
+ */
+func (queue Persistent_Queue[T]) Length() uint {
+	return queue.length
+}
+
+/**
+ * Returns new queue with item appended to end
+ */
+func (queue Persistent_Queue[T]) Enqueue(item T) Persistent_Queue[T] {
+	queue.length++
+	queue.rear = &persistent_node[T]{
+		value: item,
+		next:  queue.rear,
+	}
+	return queue.check()
+}
+
+/**
+ * Returns first item of queue and new queue without it, or an error
+ */
+func (queue Persistent_Queue[T]) Deque() (T, Persistent_Queue[T], error) {
+	cell := queue.front.force()
+	if cell == nil {
+		var result T
+		return result, queue, &common_errors.Empty_Error{Container: "Queue"}
+	}
+
+	queue.length--
+	queue.front_length--
+	queue.front = cell.next
+
+	return cell.value, queue.check(), nil
+}
+
+/**
+ * Returns first value of queue
+ */
+func (queue Persistent_Queue[T]) Peek() (T, error) {
+	cell := queue.front.force()
+	if cell == nil {
+		var result T
+		return result, &common_errors.Empty_Error{Container: "Queue"}
+	}
+
+	return cell.value, nil
+}
+
+// Schedule `front ++ reverse(rear)` once rear grows longer than front
+func (queue Persistent_Queue[T]) check() Persistent_Queue[T] {
+	if queue.length-queue.front_length <= queue.front_length {
+		return queue
+	}
+
+	queue.front = appendStream(queue.front, reverseStream(queue.rear))
+	queue.front_length = queue.length
+	queue.rear = nil
+	return queue
+}
+
+// Okasaki real-time queue, same contract as `Persistent_Queue` with every
+// operation `O(1)` in the worst case rather than amortized
+//
+// @notes
+//
+// - `schedule` is a suffix of `front`, each operation forces one cell of it
+// so rotation work is spread evenly rather than paid all at once
+// - Invariant is `len(schedule) == len(front) - len(rear)`
+type Real_Time_Queue[T any] struct {
+	length   uint
+	front    *stream[T]
+	rear     *persistent_node[T]
+	schedule *stream[T]
+}
+
+/**
+ * Returns number of items, kept unexported so it always matches the streams
+ */
+func (queue Real_Time_Queue[T]) Length() uint {
+	return queue.length
+}
+
+/**
+ * Returns new queue with item appended to end
+ */
+func (queue Real_Time_Queue[T]) Enqueue(item T) Real_Time_Queue[T] {
+	queue.length++
+	queue.rear = &persistent_node[T]{
+		value: item,
+		next:  queue.rear,
+	}
+	return queue.exec()
+}
+
+/**
+ * Returns first item of queue and new queue without it, or an error
+ */
+func (queue Real_Time_Queue[T]) Deque() (T, Real_Time_Queue[T], error) {
+	cell := queue.front.force()
+	if cell == nil {
+		var result T
+		return result, queue, &common_errors.Empty_Error{Container: "Queue"}
+	}
+
+	queue.length--
+	queue.front = cell.next
+
+	return cell.value, queue.exec(), nil
+}
+
+/**
+ * Returns first value of queue
+ */
+func (queue Real_Time_Queue[T]) Peek() (T, error) {
+	cell := queue.front.force()
+	if cell == nil {
+		var result T
+		return result, &common_errors.Empty_Error{Container: "Queue"}
+	}
+
+	return cell.value, nil
+}
+
+// Force one scheduled cell, or start a new rotation once schedule runs out
+func (queue Real_Time_Queue[T]) exec() Real_Time_Queue[T] {
+	if cell := queue.schedule.force(); cell != nil {
+		queue.schedule = cell.next
+		return queue
+	}
+
+	queue.front = rotateStream(queue.front, queue.rear, nil)
+	queue.rear = nil
+	queue.schedule = queue.front
+	return queue
+}
+
+// Incremental `front ++ reverse(rear) ++ accumulator`, relies on
+// `len(rear) == len(front) + 1` which `exec` guarantees
+func rotateStream[T any](front *stream[T], rear *persistent_node[T], accumulator *stream[T]) *stream[T] {
+	return lazyStream(func() *stream_cell[T] {
+		if rear == nil {
+			return accumulator.force()
+		}
+
+		reversed := &stream[T]{
+			cell: &stream_cell[T]{value: rear.value, next: accumulator},
+		}
+
+		cell := front.force()
+		if cell == nil {
+			return reversed.cell
+		}
+
+		return &stream_cell[T]{
+			value: cell.value,
+			next:  rotateStream(cell.next, rear.next, reversed),
+		}
+	})
+}
@@ -0,0 +1,180 @@
+package queue
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	common_errors "common-errors"
+)
+
+// Shared surface of both persistent queues so every test runs against each
+type persistent_queue[T any, Q any] interface {
+	Enqueue(item T) Q
+	Deque() (T, Q, error)
+	Peek() (T, error)
+	Length() uint
+}
+
+func testPersistentQueueFIFO[Q persistent_queue[uint, Q]](t *testing.T, queue Q) {
+	limit := uint(100)
+
+	for i := uint(0); i < limit; i++ {
+		queue = queue.Enqueue(i)
+	}
+
+	if queue.Length() != limit {
+		t.Fatalf(`Expected queue.Length() of %v but got %v`, limit, queue.Length())
+	}
+
+	for i := uint(0); i < limit; i++ {
+		peek, err := queue.Peek()
+		if err != nil {
+			t.Fatalf(`Unexpected error %v`, err)
+		}
+
+		var value uint
+		value, queue, err = queue.Deque()
+		if err != nil {
+			t.Fatalf(`Unexpected error %v`, err)
+		}
+
+		if value != i || peek != i {
+			t.Fatalf(`Expected queue value of %v but got %v and peeked %v`, i, value, peek)
+		}
+
+		if queue.Length() != limit-i-1 {
+			t.Fatalf(`Expected queue length of %v but got %v`, limit-i-1, queue.Length())
+		}
+	}
+
+	if _, _, err := queue.Deque(); !errors.Is(err, common_errors.ErrEmpty) {
+		t.Fatalf(`Expected ErrEmpty but got %v`, err)
+	}
+	if _, err := queue.Peek(); !errors.Is(err, common_errors.ErrEmpty) {
+		t.Fatalf(`Expected ErrEmpty but got %v`, err)
+	}
+}
+
+func testPersistentQueueKeepsVersions[Q persistent_queue[uint, Q]](t *testing.T, queue Q) {
+	limit := uint(50)
+	versions := make([]Q, 0, limit+1)
+	models := make([][]uint, 0, limit+1)
+	versions = append(versions, queue)
+	models = append(models, []uint{})
+
+	// Interleave Enqueue and Deque so versions hold rotated and unrotated fronts
+	model := []uint{}
+	for i := uint(0); i < limit; i++ {
+		queue = queue.Enqueue(i)
+		model = append(model, i)
+		if i%3 == 2 {
+			_, queue, _ = queue.Deque()
+			model = model[1:]
+		}
+		versions = append(versions, queue)
+		models = append(models, append([]uint{}, model...))
+	}
+
+	for i, version := range versions {
+		expected := models[i]
+		if version.Length() != uint(len(expected)) {
+			t.Fatalf(`Version %v expected length %v but got %v`, i, len(expected), version.Length())
+		}
+
+		for _, expected_value := range expected {
+			value, next, err := version.Deque()
+			if err != nil {
+				t.Fatalf(`Version %v unexpected error %v`, i, err)
+			}
+			if value != expected_value {
+				t.Fatalf(`Version %v expected value %v but got %v`, i, expected_value, value)
+			}
+			version = next
+		}
+	}
+
+	// Branching from an old version must not disturb its sibling
+	base := versions[10]
+	left := base.Enqueue(1000)
+	right := base.Enqueue(2000)
+	for left.Length() > 1 {
+		_, left, _ = left.Deque()
+		_, right, _ = right.Deque()
+	}
+
+	left_value, _ := left.Peek()
+	right_value, _ := right.Peek()
+	if left_value != 1000 || right_value != 2000 {
+		t.Fatalf(`Expected branches to end with 1000 and 2000 but got %v and %v`, left_value, right_value)
+	}
+}
+
+// Many goroutines draining one shared version, run with `go test -race`
+func testPersistentQueueSharedAcrossGoroutines[Q persistent_queue[uint, Q]](t *testing.T, queue Q) {
+	limit := uint(500)
+	for i := uint(0); i < limit; i++ {
+		queue = queue.Enqueue(i)
+	}
+
+	var group sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		group.Add(1)
+		go func(version Q) {
+			defer group.Done()
+			for i := uint(0); i < limit; i++ {
+				value, next, err := version.Deque()
+				if err != nil || value != i {
+					t.Errorf(`Expected value %v but got %v with error %v`, i, value, err)
+					return
+				}
+				version = next
+			}
+		}(queue)
+	}
+	group.Wait()
+}
+
+func Test_Persistent_Queue_is_first_in_first_out(t *testing.T) {
+	testPersistentQueueFIFO(t, Persistent_Queue[uint]{})
+}
+
+func Test_Persistent_Queue_keeps_old_versions_valid(t *testing.T) {
+	testPersistentQueueKeepsVersions(t, Persistent_Queue[uint]{})
+}
+
+func Test_Persistent_Queue_is_safe_to_share_across_goroutines(t *testing.T) {
+	testPersistentQueueSharedAcrossGoroutines(t, Persistent_Queue[uint]{})
+}
+
+func Test_Real_Time_Queue_is_first_in_first_out(t *testing.T) {
+	testPersistentQueueFIFO(t, Real_Time_Queue[uint]{})
+}
+
+func Test_Real_Time_Queue_keeps_old_versions_valid(t *testing.T) {
+	testPersistentQueueKeepsVersions(t, Real_Time_Queue[uint]{})
+}
+
+func Test_Real_Time_Queue_is_safe_to_share_across_goroutines(t *testing.T) {
+	testPersistentQueueSharedAcrossGoroutines(t, Real_Time_Queue[uint]{})
+}
+
+func Benchmark_Persistent_Queue_enqueue_deque(b *testing.B) {
+	queue := Persistent_Queue[int]{}
+	for i := 0; i < b.N; i++ {
+		queue = queue.Enqueue(i)
+		if i%2 == 1 {
+			_, queue, _ = queue.Deque()
+		}
+	}
+}
+
+func Benchmark_Real_Time_Queue_enqueue_deque(b *testing.B) {
+	queue := Real_Time_Queue[int]{}
+	for i := 0; i < b.N; i++ {
+		queue = queue.Enqueue(i)
+		if i%2 == 1 {
+			_, queue, _ = queue.Deque()
+		}
+	}
+}
@@ -1,6 +1,6 @@
 package queue
 
-import "errors"
+import common_errors "common-errors"
 
 type Node[T any] struct {
 	value T
@@ -39,7 +39,7 @@ func (queue *Queue[T]) Enqueue(item T) {
 func (queue *Queue[T]) Deque() (T, error) {
 	if queue.Length == 0 {
 		var result T
-		return result, errors.New("Queue is empty")
+		return result, &common_errors.Empty_Error{Container: "Queue"}
 	}
 
 	queue.Length--
@@ -55,7 +55,7 @@ func (queue *Queue[T]) Deque() (T, error) {
 func (queue *Queue[T]) Peek() (T, error) {
 	if queue.Length == 0 {
 		var result T
-		return result, errors.New("Queue is empty")
+		return result, &common_errors.Empty_Error{Container: "Queue"}
 	}
 
 	return queue.head.value, nil
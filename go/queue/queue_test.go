@@ -1,7 +1,10 @@
 package queue
 
 import (
+	"errors"
 	"testing"
+
+	common_errors "common-errors"
 )
 
 func Test_Enqueue_increments_length(t *testing.T) {
@@ -22,8 +25,13 @@ func Test_Deque_returns_error_for_empty_queue(t *testing.T) {
 	queue := Queue[uint]{}
 
 	value, err := queue.Deque()
-	if err == nil {
-		t.Fatalf(`Expected error not nil -> %v`, err)
+	if !errors.Is(err, common_errors.ErrEmpty) {
+		t.Fatalf(`Expected ErrEmpty but got %v`, err)
+	}
+
+	var empty *common_errors.Empty_Error
+	if !errors.As(err, &empty) || empty.Container != "Queue" {
+		t.Fatalf(`Expected *Empty_Error for Queue but got %v`, err)
 	}
 
 	var expected_value uint
@@ -84,8 +92,8 @@ func Test_Peek_returns_error_for_empty_queue(t *testing.T) {
 	queue := Queue[uint]{}
 
 	value, err := queue.Peek()
-	if err == nil {
-		t.Fatalf(`Expected error not nil -> %v`, err)
+	if !errors.Is(err, common_errors.ErrEmpty) {
+		t.Fatalf(`Expected ErrEmpty but got %v`, err)
 	}
 
 	var expected_value uint
@@ -0,0 +1,168 @@
+package queue
+
+import (
+	common_errors "common-errors"
+	"errors"
+	"sync/atomic"
+)
+
+// Returned by `Steal` when another thief or owner took the item first
+var ErrLostRace = errors.New("Lost race for item")
+
+const work_stealing_initial_size = 32
+
+// Growable circular buffer, slots hold pointers so thieves can read them
+// atomically while the owner writes
+type work_stealing_ring[T any] struct {
+	slots []atomic.Pointer[T]
+	mask  int64
+}
+
+func newWorkStealingRing[T any](size int64) *work_stealing_ring[T] {
+	return &work_stealing_ring[T]{
+		slots: make([]atomic.Pointer[T], size),
+		mask:  size - 1,
+	}
+}
+
+func (ring *work_stealing_ring[T]) get(index int64) *T {
+	return ring.slots[index&ring.mask].Load()
+}
+
+func (ring *work_stealing_ring[T]) put(index int64, item *T) {
+	ring.slots[index&ring.mask].Store(item)
+}
+
+// Returns ring of double size holding the live range `[top, bottom)`
+func (ring *work_stealing_ring[T]) grow(top, bottom int64) *work_stealing_ring[T] {
+	bigger := newWorkStealingRing[T](int64(len(ring.slots)) * 2)
+	for i := top; i < bottom; i++ {
+		bigger.put(i, ring.get(i))
+	}
+	return bigger
+}
+
+// Chase–Lev work-stealing deque, one owner goroutine pushes and pops at the
+// bottom while any number of thieves steal from the top without locks
+//
+// ## Example
+//
+//	deque := Work_Stealing_Deque[Task]{}
+//	deque.Push(task)             // owner only
+//	task, err := deque.Pop()     // owner only, newest first
+//	task, err = deque.Steal()    // any goroutine, oldest first
+//
+// @notes
+//
+// - Zero value is ready to use, but must not be copied after first use
+// - Owner works LIFO for cache locality while thieves take the oldest, and
+// usually largest, piece of work
+// - Old rings are left for the garbage collector, so thieves still reading
+// one after a grow never see freed memory
+type Work_Stealing_Deque[T any] struct {
+	top    atomic.Int64
+	bottom atomic.Int64
+	ring   atomic.Pointer[work_stealing_ring[T]]
+}
+
+/**
+ * Push item onto bottom of deque, must only be called by owner
+ */
+func (deque *Work_Stealing_Deque[T]) Push(item T) {
+	bottom := deque.bottom.Load()
+	top := deque.top.Load()
+	ring := deque.getRing()
+
+	if bottom-top >= int64(len(ring.slots))-1 {
+		ring = ring.grow(top, bottom)
+		deque.ring.Store(ring)
+	}
+
+	ring.put(bottom, &item)
+	deque.bottom.Store(bottom + 1)
+}
+
+/**
+ * Remove and return newest item from bottom of deque or an error, must only
+ * be called by owner
+ */
+func (deque *Work_Stealing_Deque[T]) Pop() (T, error) {
+	var result T
+
+	// Claim bottom slot before looking at top, so a racing thief either sees
+	// the claim or the owner sees the thief's increment of top
+	bottom := deque.bottom.Load() - 1
+	ring := deque.getRing()
+	deque.bottom.Store(bottom)
+	top := deque.top.Load()
+
+	if top > bottom {
+		deque.bottom.Store(top)
+		return result, &common_errors.Empty_Error{Container: "Deque"}
+	}
+
+	item := ring.get(bottom)
+
+	if top == bottom {
+		// Last item, thieves may want it too
+		won := deque.top.CompareAndSwap(top, top+1)
+		deque.bottom.Store(top + 1)
+		if !won {
+			return result, &common_errors.Empty_Error{Container: "Deque"}
+		}
+	}
+
+	// No thief reads this slot again until owner pushes over it
+	ring.put(bottom, nil)
+	return *item, nil
+}
+
+/**
+ * Remove and return oldest item from top of deque, safe from any goroutine
+ *
+ * Returns `*common_errors.Empty_Error` when deque is empty or `ErrLostRace`
+ * when another goroutine claimed the item first, callers may simply try
+ * again or move on to another victim
+ */
+func (deque *Work_Stealing_Deque[T]) Steal() (T, error) {
+	var result T
+
+	top := deque.top.Load()
+	bottom := deque.bottom.Load()
+	if top >= bottom {
+		return result, &common_errors.Empty_Error{Container: "Deque"}
+	}
+
+	// Slot must be read before claiming it, afterwards owner may reuse it
+	item := deque.ring.Load().get(top)
+	if !deque.top.CompareAndSwap(top, top+1) {
+		return result, ErrLostRace
+	}
+
+	return *item, nil
+}
+
+/**
+ * Returns snapshot of number of items in deque
+ */
+func (deque *Work_Stealing_Deque[T]) Length() uint {
+	length := deque.bottom.Load() - deque.top.Load()
+	if length < 0 {
+		// Pop briefly moves bottom below top on an empty deque
+		return 0
+	}
+	return uint(length)
+}
+
+// Lazily allocate ring so zero value deques are usable
+//
+// @note - only the owner calls this, `Steal` loads ring directly since a
+// non-empty deque always has one
+func (deque *Work_Stealing_Deque[T]) getRing() *work_stealing_ring[T] {
+	ring := deque.ring.Load()
+	if ring == nil {
+		ring = newWorkStealingRing[T](work_stealing_initial_size)
+		deque.ring.Store(ring)
+	}
+	return ring
+}
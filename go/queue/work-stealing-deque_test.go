@@ -0,0 +1,167 @@
+package queue
+
+import (
+	"errors"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	common_errors "common-errors"
+)
+
+func Test_Work_Stealing_Deque_Pop_is_last_in_first_out(t *testing.T) {
+	deque := Work_Stealing_Deque[int]{}
+
+	limit := 100
+	for i := 0; i < limit; i++ {
+		deque.Push(i)
+	}
+
+	if deque.Length() != uint(limit) {
+		t.Fatalf(`Expected deque.Length() of %v but got %v`, limit, deque.Length())
+	}
+
+	for i := limit - 1; i >= 0; i-- {
+		value, err := deque.Pop()
+		if err != nil {
+			t.Fatalf(`Unexpected error %v`, err)
+		}
+		if value != i {
+			t.Fatalf(`Expected value %v but got %v`, i, value)
+		}
+	}
+
+	if _, err := deque.Pop(); !errors.Is(err, common_errors.ErrEmpty) {
+		t.Fatalf(`Expected ErrEmpty but got %v`, err)
+	}
+	if deque.Length() != 0 {
+		t.Fatalf(`Expected deque.Length() of 0 but got %v`, deque.Length())
+	}
+}
+
+func Test_Work_Stealing_Deque_Steal_is_first_in_first_out(t *testing.T) {
+	deque := Work_Stealing_Deque[int]{}
+
+	if _, err := deque.Steal(); !errors.Is(err, common_errors.ErrEmpty) {
+		t.Fatalf(`Expected ErrEmpty but got %v`, err)
+	}
+
+	limit := 100
+	for i := 0; i < limit; i++ {
+		deque.Push(i)
+	}
+
+	for i := 0; i < limit; i++ {
+		value, err := deque.Steal()
+		if err != nil {
+			t.Fatalf(`Unexpected error %v`, err)
+		}
+		if value != i {
+			t.Fatalf(`Expected value %v but got %v`, i, value)
+		}
+	}
+
+	if _, err := deque.Steal(); !errors.Is(err, common_errors.ErrEmpty) {
+		t.Fatalf(`Expected ErrEmpty but got %v`, err)
+	}
+}
+
+func Test_Work_Stealing_Deque_grows_and_wraps(t *testing.T) {
+	deque := Work_Stealing_Deque[int]{}
+
+	// Steal from top while pushing so live range wraps around the ring
+	next_steal := 0
+	for i := 0; i < work_stealing_initial_size*5; i++ {
+		deque.Push(i)
+		if i%3 == 0 {
+			value, err := deque.Steal()
+			if err != nil || value != next_steal {
+				t.Fatalf(`Expected to steal %v but got %v with error %v`, next_steal, value, err)
+			}
+			next_steal++
+		}
+	}
+
+	for i := work_stealing_initial_size*5 - 1; i >= next_steal; i-- {
+		value, err := deque.Pop()
+		if err != nil || value != i {
+			t.Fatalf(`Expected to pop %v but got %v with error %v`, i, value, err)
+		}
+	}
+}
+
+// Owner pushes and pops while thieves steal, every value must be taken
+// exactly once, run with `go test -race`
+func Test_Work_Stealing_Deque_owner_and_thieves_take_each_item_once(t *testing.T) {
+	deque := Work_Stealing_Deque[int]{}
+
+	limit := 20000
+	thieves := 6
+	taken := make([]atomic.Int32, limit)
+	var total atomic.Int64
+
+	var stop atomic.Bool
+	var group sync.WaitGroup
+	for i := 0; i < thieves; i++ {
+		group.Add(1)
+		go func() {
+			defer group.Done()
+			for !stop.Load() {
+				if value, err := deque.Steal(); err == nil {
+					taken[value].Add(1)
+					total.Add(1)
+				}
+			}
+		}()
+	}
+
+	for i := 0; i < limit; i++ {
+		deque.Push(i)
+		if i%4 == 0 {
+			if value, err := deque.Pop(); err == nil {
+				taken[value].Add(1)
+				total.Add(1)
+			}
+		}
+	}
+
+	for {
+		value, err := deque.Pop()
+		if err != nil {
+			break
+		}
+		taken[value].Add(1)
+		total.Add(1)
+	}
+
+	// Remaining items were claimed by thieves that have yet to record them
+	for total.Load() < int64(limit) {
+		runtime.Gosched()
+	}
+
+	stop.Store(true)
+	group.Wait()
+
+	for value := range taken {
+		if count := taken[value].Load(); count != 1 {
+			t.Fatalf(`Expected value %v taken once but was taken %v times`, value, count)
+		}
+	}
+}
+
+func Benchmark_Work_Stealing_Deque_owner_push_pop(b *testing.B) {
+	deque := Work_Stealing_Deque[int]{}
+	for i := 0; i < b.N; i++ {
+		deque.Push(i)
+		deque.Pop()
+	}
+}
+
+func Benchmark_Mutex_Queue_owner_enqueue_deque(b *testing.B) {
+	queue := mutex_queue[int]{}
+	for i := 0; i < b.N; i++ {
+		queue.Enqueue(i)
+		queue.Deque()
+	}
+}
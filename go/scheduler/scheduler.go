@@ -0,0 +1,268 @@
+package scheduler
+
+import (
+	"math/rand"
+	"queue"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// Unit of work, receives the worker running it so it can `Fork` children
+type Task func(worker *Worker)
+
+// Counts outstanding tasks forked through it, see `Worker.Join`
+type Group struct {
+	pending atomic.Int64
+}
+
+// Goroutine owning one work-stealing deque
+type Worker struct {
+	Id        int
+	scheduler *Scheduler
+	deque     queue.Work_Stealing_Deque[Task]
+	random    *rand.Rand
+}
+
+// Fork-join task runner, each worker owns a deque and idle workers steal from
+// randomly chosen victims before parking
+//
+// ## Example
+//
+//	scheduler := New_Scheduler(runtime.NumCPU())
+//	defer scheduler.Close()
+//
+//	scheduler.Submit(func(worker *Worker) {
+//		group := Group{}
+//		worker.Fork(&group, left_half)
+//		worker.Fork(&group, right_half)
+//		worker.Join(&group)
+//	})
+//	scheduler.Wait()
+//
+// @notes
+//
+// - Tasks from outside any worker go through one mutex guarded `queue.Queue`
+// injector, tasks forked by workers never touch a lock
+// - A panicking task crashes the process, same as a bare goroutine
+type Scheduler struct {
+	workers []*Worker
+	running sync.WaitGroup
+
+	// Guards injector and closed, and backs both condition variables
+	mutex    sync.Mutex
+	injector queue.Queue[Task]
+	closed   bool
+
+	// Parked workers wait on `wake`, callers of `Wait` on `idle`
+	wake *sync.Cond
+	idle *sync.Cond
+
+	sleeping atomic.Int32
+
+	// Submitted or forked tasks that have not yet returned
+	active atomic.Int64
+}
+
+// Start `count` workers, which park until tasks are submitted
+func New_Scheduler(count int) *Scheduler {
+	if count < 1 {
+		count = 1
+	}
+
+	scheduler := &Scheduler{
+		workers: make([]*Worker, count),
+	}
+	scheduler.wake = sync.NewCond(&scheduler.mutex)
+	scheduler.idle = sync.NewCond(&scheduler.mutex)
+
+	for i := range scheduler.workers {
+		scheduler.workers[i] = &Worker{
+			Id:        i,
+			scheduler: scheduler,
+			random:    rand.New(rand.NewSource(int64(i) + 1)),
+		}
+	}
+
+	scheduler.running.Add(count)
+	for _, worker := range scheduler.workers {
+		go worker.loop()
+	}
+
+	return scheduler
+}
+
+/**
+ * Queue task from any goroutine, use `Worker.Fork` from inside tasks instead
+ */
+func (scheduler *Scheduler) Submit(task Task) {
+	scheduler.active.Add(1)
+
+	scheduler.mutex.Lock()
+	scheduler.injector.Enqueue(task)
+	scheduler.mutex.Unlock()
+
+	scheduler.wakeOne()
+}
+
+/**
+ * Block until every submitted task, and everything they forked, has returned
+ */
+func (scheduler *Scheduler) Wait() {
+	scheduler.mutex.Lock()
+	defer scheduler.mutex.Unlock()
+
+	for scheduler.active.Load() > 0 {
+		scheduler.idle.Wait()
+	}
+}
+
+/**
+ * Wait for outstanding tasks then stop every worker goroutine
+ */
+func (scheduler *Scheduler) Close() {
+	scheduler.Wait()
+
+	scheduler.mutex.Lock()
+	scheduler.closed = true
+	scheduler.wake.Broadcast()
+	scheduler.mutex.Unlock()
+
+	scheduler.running.Wait()
+}
+
+/**
+ * Push task onto this worker's deque where idle workers may steal it, group
+ * may be nil when caller does not need to `Join`
+ */
+func (worker *Worker) Fork(group *Group, task Task) {
+	if group != nil {
+		group.pending.Add(1)
+		inner := task
+		task = func(worker *Worker) {
+			defer group.pending.Add(-1)
+			inner(worker)
+		}
+	}
+
+	worker.scheduler.active.Add(1)
+	worker.deque.Push(task)
+	worker.scheduler.wakeOne()
+}
+
+/**
+ * Run other tasks, local or stolen, until every task forked into group has
+ * returned, so joining never leaves this worker's goroutine idle
+ */
+func (worker *Worker) Join(group *Group) {
+	for group.pending.Load() > 0 {
+		if task, ok := worker.find(); ok {
+			worker.run(task)
+			continue
+		}
+		runtime.Gosched()
+	}
+}
+
+func (worker *Worker) loop() {
+	defer worker.scheduler.running.Done()
+
+	for {
+		if task, ok := worker.find(); ok {
+			worker.run(task)
+			continue
+		}
+		if !worker.park() {
+			return
+		}
+	}
+}
+
+func (worker *Worker) run(task Task) {
+	task(worker)
+
+	scheduler := worker.scheduler
+	if scheduler.active.Add(-1) == 0 {
+		scheduler.mutex.Lock()
+		scheduler.idle.Broadcast()
+		scheduler.mutex.Unlock()
+	}
+}
+
+// Own deque first, then injector, then one pass over every other worker
+// starting from a random victim so thieves spread out
+func (worker *Worker) find() (Task, bool) {
+	if task, err := worker.deque.Pop(); err == nil {
+		return task, true
+	}
+
+	scheduler := worker.scheduler
+
+	scheduler.mutex.Lock()
+	task, err := scheduler.injector.Deque()
+	scheduler.mutex.Unlock()
+	if err == nil {
+		return task, true
+	}
+
+	count := len(scheduler.workers)
+	start := worker.random.Intn(count)
+	for i := 0; i < count; i++ {
+		victim := scheduler.workers[(start+i)%count]
+		if victim == worker {
+			continue
+		}
+		if task, err := victim.deque.Steal(); err == nil {
+			return task, true
+		}
+	}
+
+	return nil, false
+}
+
+// Sleep until work shows up, returns false once scheduler is closed
+//
+// @note - `sleeping` is raised before work is re-checked, and `wakeOne` reads
+// it after publishing work, so either this check or that signal sees the other
+func (worker *Worker) park() bool {
+	scheduler := worker.scheduler
+
+	scheduler.mutex.Lock()
+	defer scheduler.mutex.Unlock()
+
+	scheduler.sleeping.Add(1)
+	defer scheduler.sleeping.Add(-1)
+
+	for {
+		if scheduler.hasWork() {
+			return true
+		}
+		if scheduler.closed {
+			return false
+		}
+		scheduler.wake.Wait()
+	}
+}
+
+// @note - callers must hold mutex
+func (scheduler *Scheduler) hasWork() bool {
+	if scheduler.injector.Length > 0 {
+		return true
+	}
+	for _, worker := range scheduler.workers {
+		if worker.deque.Length() > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (scheduler *Scheduler) wakeOne() {
+	if scheduler.sleeping.Load() == 0 {
+		return
+	}
+
+	scheduler.mutex.Lock()
+	scheduler.wake.Signal()
+	scheduler.mutex.Unlock()
+}
@@ -0,0 +1,117 @@
+package scheduler
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func fibonacci(worker *Worker, n int, result *int) {
+	if n < 2 {
+		*result = n
+		return
+	}
+
+	var left, right int
+	group := Group{}
+	worker.Fork(&group, func(worker *Worker) { fibonacci(worker, n-1, &left) })
+	worker.Fork(&group, func(worker *Worker) { fibonacci(worker, n-2, &right) })
+	worker.Join(&group)
+
+	*result = left + right
+}
+
+func Test_Fork_and_Join_compute_recursive_results(t *testing.T) {
+	scheduler := New_Scheduler(4)
+	defer scheduler.Close()
+
+	var result int
+	scheduler.Submit(func(worker *Worker) {
+		fibonacci(worker, 20, &result)
+	})
+	scheduler.Wait()
+
+	if result != 6765 {
+		t.Fatalf(`Expected fibonacci(20) of 6765 but got %v`, result)
+	}
+}
+
+// Run with `go test -race`
+func Test_Every_submitted_and_forked_task_runs_exactly_once(t *testing.T) {
+	scheduler := New_Scheduler(8)
+	defer scheduler.Close()
+
+	submitters := 4
+	per_submitter := 50
+	children := 20
+
+	total := submitters * per_submitter * children
+	runs := make([]atomic.Int32, total)
+
+	var group sync.WaitGroup
+	for s := 0; s < submitters; s++ {
+		group.Add(1)
+		go func(s int) {
+			defer group.Done()
+			for p := 0; p < per_submitter; p++ {
+				offset := (s*per_submitter + p) * children
+				scheduler.Submit(func(worker *Worker) {
+					for c := 0; c < children; c++ {
+						index := offset + c
+						worker.Fork(nil, func(*Worker) { runs[index].Add(1) })
+					}
+				})
+			}
+		}(s)
+	}
+	group.Wait()
+	scheduler.Wait()
+
+	for index := range runs {
+		if count := runs[index].Load(); count != 1 {
+			t.Fatalf(`Expected task %v to run once but it ran %v times`, index, count)
+		}
+	}
+}
+
+func Test_Idle_workers_steal_forked_tasks(t *testing.T) {
+	scheduler := New_Scheduler(2)
+	defer scheduler.Close()
+
+	release := make(chan struct{})
+	var owner_id, releaser_id, waiter_id int
+
+	scheduler.Submit(func(worker *Worker) {
+		owner_id = worker.Id
+		group := Group{}
+
+		// Owner pops newest first, so unless `waiter` was already stolen the
+		// owner blocks in it and only a thief taking `releaser` can free it
+		worker.Fork(&group, func(worker *Worker) {
+			releaser_id = worker.Id
+			close(release)
+		})
+		worker.Fork(&group, func(worker *Worker) {
+			waiter_id = worker.Id
+			<-release
+		})
+		worker.Join(&group)
+	})
+	scheduler.Wait()
+
+	if releaser_id == owner_id && waiter_id == owner_id {
+		t.Fatalf(`Expected a forked task to be stolen but both ran on owner %v`, owner_id)
+	}
+}
+
+func Test_Close_stops_parked_workers(t *testing.T) {
+	scheduler := New_Scheduler(3)
+
+	var ran atomic.Bool
+	scheduler.Submit(func(*Worker) { ran.Store(true) })
+	scheduler.Close()
+
+	if !ran.Load() {
+		t.Fatalf(`Expected submitted task to run before Close returned`)
+	}
+}
@@ -0,0 +1,326 @@
+package skip_list
+
+import (
+	"cmp"
+	"iter"
+	"math/rand/v2"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	common_errors "common-errors"
+)
+
+type concurrent_node[T any] struct {
+	value T
+	next  []atomic.Pointer[concurrent_node[T]]
+	mutex sync.Mutex
+
+	// Logically deleted, set under `mutex` before any link is cut
+	marked atomic.Bool
+
+	// Linked at every level, until then readers treat node as absent
+	fully_linked atomic.Bool
+}
+
+// Ordered set safe for concurrent use, writers lock only the nodes around
+// the change and readers never lock
+//
+// ## Example
+//
+//	list := New_Concurrent_Skip_List[int](nil)
+//	var group sync.WaitGroup
+//	for i := 0; i < 8; i++ {
+//		group.Add(1)
+//		go func(i int) {
+//			defer group.Done()
+//			list.Insert(i)
+//		}(i)
+//	}
+//	group.Wait()
+//
+// @notes
+//
+// - Lazy locking after Herlihy, Lev, Luchangco and Shavit, `Insert` and
+// `Delete` lock at most one node per level while `Contains`, `Find`,
+// `Ceiling` and iterators never lock or retry
+// - Iterators are weakly consistent, they never repeat an item but may miss
+// items inserted or deleted while they run
+// - No rank lookups, keeping spans exact would need every writer to lock the
+// whole search path
+type Concurrent_Skip_List[T any] struct {
+	head    *concurrent_node[T]
+	length  atomic.Int64
+	compare func(a, b T) int
+
+	rng_mutex sync.Mutex
+	rng       *rand.Rand
+}
+
+// Concurrent skip list of naturally ordered items, a `nil` rng seeds one at
+// random
+func New_Concurrent_Skip_List[T cmp.Ordered](rng *rand.Rand) *Concurrent_Skip_List[T] {
+	return New_Concurrent_Skip_List_Func(cmp.Compare[T], rng)
+}
+
+// Concurrent skip list ordered by compare, see `New_Skip_List_Func`
+func New_Concurrent_Skip_List_Func[T any](compare func(a, b T) int, rng *rand.Rand) *Concurrent_Skip_List[T] {
+	return &Concurrent_Skip_List[T]{
+		head: &concurrent_node[T]{
+			next: make([]atomic.Pointer[concurrent_node[T]], Max_Level),
+		},
+		compare: compare,
+		rng:     seededOrRandom(rng),
+	}
+}
+
+// Number of items, exact only while no writer is running
+func (list *Concurrent_Skip_List[T]) Length() uint {
+	length := list.length.Load()
+	if length < 0 {
+		// Delete may decrement before the racing Insert that linked its
+		// item increments
+		return 0
+	}
+	return uint(length)
+}
+
+// Add item in order, returns false and leaves list untouched when an equal
+// item is already present
+func (list *Concurrent_Skip_List[T]) Insert(item T) bool {
+	list.rng_mutex.Lock()
+	level := randomLevel(list.rng)
+	list.rng_mutex.Unlock()
+
+	var preds, succs [Max_Level]*concurrent_node[T]
+	for {
+		found := list.find(item, &preds, &succs)
+		if found != -1 {
+			existing := succs[found]
+			if !existing.marked.Load() {
+				// Wait out a concurrent insert of the same item so callers
+				// never see false before the item is visible
+				for !existing.fully_linked.Load() {
+					runtime.Gosched()
+				}
+				return false
+			}
+
+			// Equal item is being deleted, retry once it is unlinked
+			runtime.Gosched()
+			continue
+		}
+
+		highest, valid := lockPreds(&preds, level, func(i int, pred *concurrent_node[T]) bool {
+			succ := succs[i]
+			return !pred.marked.Load() && (succ == nil || !succ.marked.Load()) && pred.next[i].Load() == succ
+		})
+		if !valid {
+			unlockPreds(&preds, highest)
+			continue
+		}
+
+		node := &concurrent_node[T]{
+			value: item,
+			next:  make([]atomic.Pointer[concurrent_node[T]], level),
+		}
+		for i := 0; i < level; i++ {
+			node.next[i].Store(succs[i])
+		}
+		for i := 0; i < level; i++ {
+			preds[i].next[i].Store(node)
+		}
+		node.fully_linked.Store(true)
+
+		unlockPreds(&preds, highest)
+		list.length.Add(1)
+		return true
+	}
+}
+
+// Remove and return item equal to given item
+func (list *Concurrent_Skip_List[T]) Delete(item T) (T, error) {
+	var preds, succs [Max_Level]*concurrent_node[T]
+	var victim *concurrent_node[T]
+	for {
+		found := list.find(item, &preds, &succs)
+
+		if victim == nil {
+			// Only a fully linked node found at its top level is safe to
+			// claim, anything else is mid insert or already claimed
+			var candidate *concurrent_node[T]
+			if found != -1 {
+				candidate = succs[found]
+			}
+			if candidate == nil || !candidate.fully_linked.Load() || len(candidate.next)-1 != found || candidate.marked.Load() {
+				var result T
+				return result, &common_errors.Not_Found_Error{Target: "Value", Container: "skip list"}
+			}
+
+			candidate.mutex.Lock()
+			if candidate.marked.Load() {
+				candidate.mutex.Unlock()
+				var result T
+				return result, &common_errors.Not_Found_Error{Target: "Value", Container: "skip list"}
+			}
+			candidate.marked.Store(true)
+			victim = candidate
+		}
+
+		highest, valid := lockPreds(&preds, len(victim.next), func(i int, pred *concurrent_node[T]) bool {
+			return !pred.marked.Load() && pred.next[i].Load() == victim
+		})
+		if !valid {
+			unlockPreds(&preds, highest)
+			continue
+		}
+
+		// Unlink top down so victim stays reachable at every level below
+		// the ones already cut
+		for i := len(victim.next) - 1; i >= 0; i-- {
+			preds[i].next[i].Store(victim.next[i].Load())
+		}
+
+		victim.mutex.Unlock()
+		unlockPreds(&preds, highest)
+		list.length.Add(-1)
+		return victim.value, nil
+	}
+}
+
+// Report whether an item equal to given item is present
+func (list *Concurrent_Skip_List[T]) Contains(item T) bool {
+	_, err := list.Find(item)
+	return err == nil
+}
+
+// Returns stored item equal to given item
+func (list *Concurrent_Skip_List[T]) Find(item T) (T, error) {
+	var preds, succs [Max_Level]*concurrent_node[T]
+	found := list.find(item, &preds, &succs)
+	if found == -1 || !isLive(succs[found]) {
+		var result T
+		return result, &common_errors.Not_Found_Error{Target: "Value", Container: "skip list"}
+	}
+	return succs[found].value, nil
+}
+
+// Returns greatest item less than or equal to given item
+//
+// @note - retries while the closest candidate is mid insert or delete, since
+// level 0 links cannot be walked backwards to the next live item
+func (list *Concurrent_Skip_List[T]) Floor(item T) (T, error) {
+	for {
+		pred := list.head
+		for i := Max_Level - 1; i >= 0; i-- {
+			for curr := pred.next[i].Load(); curr != nil && list.compare(curr.value, item) <= 0; curr = pred.next[i].Load() {
+				pred = curr
+			}
+		}
+
+		if pred == list.head {
+			var result T
+			return result, &common_errors.Not_Found_Error{Target: "Floor", Container: "skip list"}
+		} else if isLive(pred) {
+			return pred.value, nil
+		}
+		runtime.Gosched()
+	}
+}
+
+// Returns least item greater than or equal to given item
+func (list *Concurrent_Skip_List[T]) Ceiling(item T) (T, error) {
+	var preds, succs [Max_Level]*concurrent_node[T]
+	list.find(item, &preds, &succs)
+
+	for node := succs[0]; node != nil; node = node.next[0].Load() {
+		if isLive(node) {
+			return node.value, nil
+		}
+	}
+
+	var result T
+	return result, &common_errors.Not_Found_Error{Target: "Ceiling", Container: "skip list"}
+}
+
+// Iterate values from smallest, to largest, see type notes on consistency
+func (list *Concurrent_Skip_List[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := list.head.next[0].Load(); node != nil; node = node.next[0].Load() {
+			if isLive(node) && !yield(node.value) {
+				return
+			}
+		}
+	}
+}
+
+// Iterate values from `from`, inclusive, up to `to`, exclusive
+func (list *Concurrent_Skip_List[T]) Range(from, to T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		var preds, succs [Max_Level]*concurrent_node[T]
+		list.find(from, &preds, &succs)
+
+		for node := succs[0]; node != nil; node = node.next[0].Load() {
+			if list.compare(node.value, to) >= 0 {
+				return
+			}
+			if isLive(node) && !yield(node.value) {
+				return
+			}
+		}
+	}
+}
+
+// Fill, per level, the last node sorting before item and the node after it,
+// returns highest level item was found at or -1
+func (list *Concurrent_Skip_List[T]) find(item T, preds, succs *[Max_Level]*concurrent_node[T]) int {
+	found := -1
+	pred := list.head
+	for i := Max_Level - 1; i >= 0; i-- {
+		curr := pred.next[i].Load()
+		for curr != nil && list.compare(curr.value, item) < 0 {
+			pred = curr
+			curr = pred.next[i].Load()
+		}
+		if found == -1 && curr != nil && list.compare(curr.value, item) == 0 {
+			found = i
+		}
+		preds[i] = pred
+		succs[i] = curr
+	}
+	return found
+}
+
+func isLive[T any](node *concurrent_node[T]) bool {
+	return node.fully_linked.Load() && !node.marked.Load()
+}
+
+// Lock each distinct predecessor from level 0 up, which is also list order
+// from back to front so writers cannot deadlock, stopping at the first level
+// that fails validation
+func lockPreds[T any](preds *[Max_Level]*concurrent_node[T], levels int, valid func(int, *concurrent_node[T]) bool) (int, bool) {
+	highest := -1
+	var prev *concurrent_node[T]
+	for i := 0; i < levels; i++ {
+		pred := preds[i]
+		if pred != prev {
+			pred.mutex.Lock()
+			highest = i
+			prev = pred
+		}
+		if !valid(i, pred) {
+			return highest, false
+		}
+	}
+	return highest, true
+}
+
+func unlockPreds[T any](preds *[Max_Level]*concurrent_node[T], highest int) {
+	var prev *concurrent_node[T]
+	for i := 0; i <= highest; i++ {
+		if preds[i] != prev {
+			preds[i].mutex.Unlock()
+			prev = preds[i]
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package skip_list
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+
+	common_errors "common-errors"
+)
+
+func Test_Concurrent_Skip_List_sequential_behaviour(t *testing.T) {
+	list := New_Concurrent_Skip_List[int](seeded())
+	for _, value := range []int{30, 10, 40, 20} {
+		if !list.Insert(value) {
+			t.Fatalf(`Expected Insert of %v to succeed`, value)
+		}
+	}
+	if list.Insert(20) {
+		t.Fatalf(`Expected duplicate Insert to be refused`)
+	}
+
+	if got := slices.Collect(list.Values()); !slices.Equal(got, []int{10, 20, 30, 40}) {
+		t.Fatalf(`Expected [10 20 30 40] but got %v`, got)
+	}
+	if got := slices.Collect(list.Range(15, 40)); !slices.Equal(got, []int{20, 30}) {
+		t.Fatalf(`Expected [20 30] but got %v`, got)
+	}
+	if floor, err := list.Floor(25); err != nil || floor != 20 {
+		t.Fatalf(`Expected Floor of 20 but got %v, %v`, floor, err)
+	}
+	if ceiling, err := list.Ceiling(25); err != nil || ceiling != 30 {
+		t.Fatalf(`Expected Ceiling of 30 but got %v, %v`, ceiling, err)
+	}
+	if _, err := list.Floor(5); !errors.Is(err, common_errors.ErrNotFound) {
+		t.Fatalf(`Expected ErrNotFound for Floor below smallest but got %v`, err)
+	}
+
+	if value, err := list.Delete(20); err != nil || value != 20 {
+		t.Fatalf(`Expected to delete 20 but got %v, %v`, value, err)
+	}
+	if _, err := list.Delete(20); !errors.Is(err, common_errors.ErrNotFound) {
+		t.Fatalf(`Expected ErrNotFound deleting twice but got %v`, err)
+	}
+	if list.Contains(20) || list.Length() != 3 {
+		t.Fatalf(`Expected 20 gone and Length() of 3 but got %v`, list.Length())
+	}
+	if floor, _ := list.Floor(25); floor != 10 {
+		t.Fatalf(`Expected Floor of 10 after delete but got %v`, floor)
+	}
+}
+
+// Writers race on overlapping keys, each key must end up inserted and
+// deleted a matching number of times, run with `go test -race`
+func Test_Concurrent_Skip_List_Length_clamps_negative_count(t *testing.T) {
+	list := New_Concurrent_Skip_List[int](nil)
+
+	// Delete counted ahead of the Insert that linked its item
+	list.length.Add(-1)
+	if length := list.Length(); length != 0 {
+		t.Fatalf(`Expected Length of 0 but got %v`, length)
+	}
+}
+
+func Test_Concurrent_Skip_List_writers_agree_on_membership(t *testing.T) {
+	list := New_Concurrent_Skip_List[int](seeded())
+
+	workers := 8
+	keys := 256
+	rounds := 200
+	inserted := make([][]int, workers)
+	deleted := make([][]int, workers)
+
+	var group sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		inserted[w] = make([]int, keys)
+		deleted[w] = make([]int, keys)
+
+		group.Add(1)
+		go func(w int) {
+			defer group.Done()
+			for r := 0; r < rounds; r++ {
+				for k := (w + r) % 7; k < keys; k += 7 {
+					if r%2 == 0 {
+						if list.Insert(k) {
+							inserted[w][k]++
+						}
+					} else if _, err := list.Delete(k); err == nil {
+						deleted[w][k]++
+					}
+					list.Contains(k)
+					list.Floor(k)
+				}
+			}
+		}(w)
+	}
+	group.Wait()
+
+	present := slices.Collect(list.Values())
+	if !slices.IsSorted(present) || uint(len(present)) != list.Length() {
+		t.Fatalf(`Expected %v sorted items but got %v`, list.Length(), present)
+	}
+
+	for k := 0; k < keys; k++ {
+		balance := 0
+		for w := 0; w < workers; w++ {
+			balance += inserted[w][k] - deleted[w][k]
+		}
+		_, is_present := slices.BinarySearch(present, k)
+		expected := 0
+		if is_present {
+			expected = 1
+		}
+		if balance != expected {
+			t.Fatalf(`Key %v inserted minus deleted is %v but presence is %v`, k, balance, is_present)
+		}
+	}
+}
+
+func Benchmark_Concurrent_Skip_List_parallel_mixed(b *testing.B) {
+	list := New_Concurrent_Skip_List[int](seeded())
+
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			key := i % 4096
+			switch i % 4 {
+			case 0:
+				list.Insert(key)
+			case 1:
+				list.Delete(key)
+			default:
+				list.Contains(key)
+			}
+		}
+	})
+}
@@ -0,0 +1,7 @@
+module skip-list
+
+go 1.23
+
+require common-errors v0.0.0
+
+replace common-errors => ../common-errors
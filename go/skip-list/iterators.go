@@ -0,0 +1,83 @@
+package skip_list
+
+import "iter"
+
+// Iterate from smallest, to largest, yielding rank/value pairs
+//
+// ## Example
+//
+//	for rank, value := range list.All() {
+//		fmt.Println("rank ->", rank, "value ->", value)
+//	}
+//
+// @notes
+//
+// - Next node is read before yielding, so deleting the current item is safe
+// and iteration continues with its successor
+// - Ranks count items visited, so they drift after such deletions
+// - Any other mutation during iteration has undefined results
+func (list *Skip_List[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		index := 0
+		for node := list.head.next[0]; node != nil; index++ {
+			next := node.next[0]
+			if !yield(index, node.value) {
+				return
+			}
+			node = next
+		}
+	}
+}
+
+// Iterate from largest, to smallest, yielding rank/value pairs, rank of the
+// largest item is `Length - 1` as it would be for `Get`
+//
+// @note - same mutation rules as `All`
+func (list *Skip_List[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		index := int(list.Length) - 1
+		for node := list.tail; node != nil; index-- {
+			prev := node.prev
+			if !yield(index, node.value) {
+				return
+			}
+			node = prev
+		}
+	}
+}
+
+// Iterate values from smallest, to largest
+func (list *Skip_List[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, value := range list.All() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Iterate values from `from`, inclusive, up to `to`, exclusive, finding the
+// start in `O(log n)`
+//
+// ## Example
+//
+//	// list holds 10, 20, 30, 40
+//	values := slices.Collect(list.Range(15, 40)) // 20, 30
+//
+// @note - same mutation rules as `All`
+func (list *Skip_List[T]) Range(from, to T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		update, _ := list.findPath(from)
+		for node := update[0].next[0]; node != nil; {
+			if list.compare(node.value, to) >= 0 {
+				return
+			}
+			next := node.next[0]
+			if !yield(node.value) {
+				return
+			}
+			node = next
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package skip_list
+
+import (
+	"slices"
+	"testing"
+)
+
+func Test_All_and_Backward_yield_ranks_in_order(t *testing.T) {
+	list := New_Skip_List[int](seeded())
+	for _, value := range []int{5, 1, 4, 2, 3} {
+		list.Insert(value)
+	}
+
+	for rank, value := range list.All() {
+		if value != rank+1 {
+			t.Fatalf(`Expected %v at rank %v but got %v`, rank+1, rank, value)
+		}
+	}
+
+	visited := []int{}
+	for rank, value := range list.Backward() {
+		if value != rank+1 {
+			t.Fatalf(`Expected %v at rank %v but got %v`, rank+1, rank, value)
+		}
+		visited = append(visited, value)
+	}
+	if !slices.Equal(visited, []int{5, 4, 3, 2, 1}) {
+		t.Fatalf(`Expected [5 4 3 2 1] but got %v`, visited)
+	}
+}
+
+func Test_Values_allows_deleting_current_item(t *testing.T) {
+	list := New_Skip_List[int](seeded())
+	for i := 0; i < 20; i++ {
+		list.Insert(i)
+	}
+
+	for value := range list.Values() {
+		if value%2 == 0 {
+			list.Delete(value)
+		}
+	}
+
+	expected := []int{1, 3, 5, 7, 9, 11, 13, 15, 17, 19}
+	checkInvariants(t, list, expected)
+}
+
+func Test_Range_is_half_open(t *testing.T) {
+	list := New_Skip_List[int](seeded())
+	for _, value := range []int{10, 20, 30, 40} {
+		list.Insert(value)
+	}
+
+	cases := []struct {
+		from, to int
+		expected []int
+	}{
+		{15, 40, []int{20, 30}},
+		{10, 11, []int{10}},
+		{0, 100, []int{10, 20, 30, 40}},
+		{41, 100, []int{}},
+		{30, 30, []int{}},
+	}
+	for _, c := range cases {
+		got := append([]int{}, slices.Collect(list.Range(c.from, c.to))...)
+		if !slices.Equal(got, c.expected) {
+			t.Fatalf(`Expected Range(%v, %v) of %v but got %v`, c.from, c.to, c.expected, got)
+		}
+	}
+}
@@ -0,0 +1,333 @@
+package skip_list
+
+import (
+	"cmp"
+	"math/rand/v2"
+
+	common_errors "common-errors"
+)
+
+// Most levels a node can reach, enough for `2^32` items at the default
+// promotion odds of one in two
+const Max_Level = 32
+
+// Holds value, level 0 neighbours like `doubly_linked_list.Node`, and one
+// forward link per level the node was promoted to
+type Node[T any] struct {
+	value T
+	prev  *Node[T]
+	next  []*Node[T]
+
+	// Level 0 steps from this node to `next` at each level, summed along a
+	// search path they give the rank of any node
+	span []uint
+}
+
+// Returns value held by node
+func (node *Node[T]) Value() T {
+	return node.value
+}
+
+// Ordered set with `O(log n)` expected search, insert, delete and lookup by
+// rank
+//
+// ## Example
+//
+//	list := New_Skip_List[int](rand.New(rand.NewPCG(1, 2)))
+//	list.Insert(30)
+//	list.Insert(10)
+//	list.Insert(20)
+//	floor, _ := list.Floor(25) // 20
+//	third, _ := list.Get(2)    // 30
+//
+// @notes
+//
+// - Items that compare equal are one item, `Insert` keeps the first
+// - Not safe for concurrent use, see `Concurrent_Skip_List`
+type Skip_List[T any] struct {
+	Length uint
+
+	// Sentinel holding `Max_Level` links, its value is never read
+	head *Node[T]
+	tail *Node[T]
+
+	// Levels currently in use, at least 1
+	level   int
+	compare func(a, b T) int
+	rng     *rand.Rand
+}
+
+// Skip list of naturally ordered items, a `nil` rng seeds one at random,
+// pass a seeded rng for repeatable node levels
+func New_Skip_List[T cmp.Ordered](rng *rand.Rand) *Skip_List[T] {
+	return New_Skip_List_Func(cmp.Compare[T], rng)
+}
+
+// Skip list ordered by compare, which returns a negative number when `a`
+// sorts before `b`, 0 when equal and a positive number otherwise
+func New_Skip_List_Func[T any](compare func(a, b T) int, rng *rand.Rand) *Skip_List[T] {
+	return &Skip_List[T]{
+		head: &Node[T]{
+			next: make([]*Node[T], Max_Level),
+			span: make([]uint, Max_Level),
+		},
+		level:   1,
+		compare: compare,
+		rng:     seededOrRandom(rng),
+	}
+}
+
+// Add item in order, returns false and leaves list untouched when an equal
+// item is already present
+func (list *Skip_List[T]) Insert(item T) bool {
+	update, rank := list.findPath(item)
+	if next := update[0].next[0]; next != nil && list.compare(next.value, item) == 0 {
+		return false
+	}
+
+	level := randomLevel(list.rng)
+	if level > list.level {
+		for i := list.level; i < level; i++ {
+			rank[i] = 0
+			update[i] = list.head
+			list.head.span[i] = list.Length
+		}
+		list.level = level
+	}
+
+	node := &Node[T]{
+		value: item,
+		next:  make([]*Node[T], level),
+		span:  make([]uint, level),
+	}
+
+	// Attach node to list at every level it reached, splitting the span
+	// of the link it was inserted into
+	for i := 0; i < level; i++ {
+		node.next[i] = update[i].next[i]
+		update[i].next[i] = node
+
+		node.span[i] = update[i].span[i] - (rank[0] - rank[i])
+		update[i].span[i] = rank[0] - rank[i] + 1
+	}
+
+	// Links above node now jump over one more item
+	for i := level; i < list.level; i++ {
+		update[i].span[i]++
+	}
+
+	if update[0] != list.head {
+		node.prev = update[0]
+	}
+	if node.next[0] != nil {
+		node.next[0].prev = node
+	} else {
+		list.tail = node
+	}
+
+	list.Length++
+	return true
+}
+
+// Remove and return item equal to given item
+func (list *Skip_List[T]) Delete(item T) (T, error) {
+	update, _ := list.findPath(item)
+
+	node := update[0].next[0]
+	if node == nil || list.compare(node.value, item) != 0 {
+		var result T
+		return result, &common_errors.Not_Found_Error{Target: "Value", Container: "skip list"}
+	}
+
+	list.removeNode(node, update)
+	return node.value, nil
+}
+
+// Report whether an item equal to given item is present
+func (list *Skip_List[T]) Contains(item T) bool {
+	_, err := list.Find(item)
+	return err == nil
+}
+
+// Returns stored item equal to given item, useful when compare only looks
+// at part of each item such as a key
+func (list *Skip_List[T]) Find(item T) (T, error) {
+	update, _ := list.findPath(item)
+
+	node := update[0].next[0]
+	if node == nil || list.compare(node.value, item) != 0 {
+		var result T
+		return result, &common_errors.Not_Found_Error{Target: "Value", Container: "skip list"}
+	}
+	return node.value, nil
+}
+
+// Returns greatest item less than or equal to given item
+func (list *Skip_List[T]) Floor(item T) (T, error) {
+	node := list.head
+	for i := list.level - 1; i >= 0; i-- {
+		for node.next[i] != nil && list.compare(node.next[i].value, item) <= 0 {
+			node = node.next[i]
+		}
+	}
+
+	if node == list.head {
+		var result T
+		return result, &common_errors.Not_Found_Error{Target: "Floor", Container: "skip list"}
+	}
+	return node.value, nil
+}
+
+// Returns least item greater than or equal to given item
+func (list *Skip_List[T]) Ceiling(item T) (T, error) {
+	update, _ := list.findPath(item)
+
+	node := update[0].next[0]
+	if node == nil {
+		var result T
+		return result, &common_errors.Not_Found_Error{Target: "Ceiling", Container: "skip list"}
+	}
+	return node.value, nil
+}
+
+// Returns smallest item
+func (list *Skip_List[T]) First() (T, error) {
+	node := list.head.next[0]
+	if node == nil {
+		var result T
+		return result, &common_errors.Empty_Error{Container: "Skip list"}
+	}
+	return node.value, nil
+}
+
+// Returns largest item
+func (list *Skip_List[T]) Last() (T, error) {
+	if list.tail == nil {
+		var result T
+		return result, &common_errors.Empty_Error{Container: "Skip list"}
+	}
+	return list.tail.value, nil
+}
+
+// Returns item at zero based rank index, following spans so cost is
+// `O(log n)` rather than a walk from head
+func (list *Skip_List[T]) Get(index uint) (T, error) {
+	node, err := list.getAt(index)
+	if err != nil {
+		var result T
+		return result, err
+	}
+	return node.value, nil
+}
+
+// Remove and return item at zero based rank index
+func (list *Skip_List[T]) RemoveAt(index uint) (T, error) {
+	node, err := list.getAt(index)
+	if err != nil {
+		var result T
+		return result, err
+	}
+
+	update, _ := list.findPath(node.value)
+	list.removeNode(node, update)
+	return node.value, nil
+}
+
+// Returns zero based rank of item equal to given item, the inverse of `Get`
+func (list *Skip_List[T]) Rank(item T) (uint, error) {
+	update, rank := list.findPath(item)
+
+	node := update[0].next[0]
+	if node == nil || list.compare(node.value, item) != 0 {
+		return 0, &common_errors.Not_Found_Error{Target: "Value", Container: "skip list"}
+	}
+	return rank[0], nil
+}
+
+// Record, per level, the last node sorting before item and how many items
+// come before and including it
+func (list *Skip_List[T]) findPath(item T) (update [Max_Level]*Node[T], rank [Max_Level]uint) {
+	node := list.head
+	for i := list.level - 1; i >= 0; i-- {
+		if i < list.level-1 {
+			rank[i] = rank[i+1]
+		}
+		for node.next[i] != nil && list.compare(node.next[i].value, item) < 0 {
+			rank[i] += node.span[i]
+			node = node.next[i]
+		}
+		update[i] = node
+	}
+	return update, rank
+}
+
+// Traverse spans down to item at index
+func (list *Skip_List[T]) getAt(index uint) (*Node[T], error) {
+	if list.Length == 0 {
+		return nil, &common_errors.Empty_Error{Container: "Skip list"}
+	} else if index >= list.Length {
+		return nil, &common_errors.Index_Error{Index: index, Length: list.Length}
+	}
+
+	// Spans count from 1, head sits at rank 0
+	target := index + 1
+	traversed := uint(0)
+	node := list.head
+	for i := list.level - 1; i >= 0; i-- {
+		for node.next[i] != nil && traversed+node.span[i] <= target {
+			traversed += node.span[i]
+			node = node.next[i]
+		}
+		if traversed == target {
+			return node, nil
+		}
+	}
+
+	// We should never reach this branch and is only here to satisfy type checker
+	return nil, &common_errors.Index_Error{Index: index, Length: list.Length}
+}
+
+// Unlink node using search path from `findPath`
+// @note - Callers must make sure node is in list
+func (list *Skip_List[T]) removeNode(node *Node[T], update [Max_Level]*Node[T]) {
+	for i := 0; i < list.level; i++ {
+		if update[i].next[i] == node {
+			update[i].span[i] += node.span[i] - 1
+			update[i].next[i] = node.next[i]
+		} else {
+			update[i].span[i]--
+		}
+	}
+
+	if node.next[0] != nil {
+		node.next[0].prev = node.prev
+	} else {
+		list.tail = node.prev
+	}
+
+	for list.level > 1 && list.head.next[list.level-1] == nil {
+		list.level--
+	}
+
+	list.Length--
+
+	// Free memory
+	node.prev = nil
+	clear(node.next)
+}
+
+// Levels a new node reaches, each extra level is half as likely as the last
+func randomLevel(rng *rand.Rand) int {
+	level := 1
+	for level < Max_Level && rng.Uint64()&1 == 0 {
+		level++
+	}
+	return level
+}
+
+func seededOrRandom(rng *rand.Rand) *rand.Rand {
+	if rng == nil {
+		return rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+	}
+	return rng
+}
@@ -0,0 +1,235 @@
+package skip_list
+
+import (
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+
+	common_errors "common-errors"
+)
+
+func seeded() *rand.Rand {
+	return rand.New(rand.NewPCG(1, 2))
+}
+
+// Check ordering, back links, `Length` and every span against expected
+func checkInvariants(t *testing.T, list *Skip_List[int], expected []int) {
+	t.Helper()
+
+	if list.Length != uint(len(expected)) {
+		t.Fatalf(`Expected Length %v but got %v`, len(expected), list.Length)
+	}
+
+	// Rank of each node, head sits at 0
+	rank := map[*Node[int]]uint{list.head: 0}
+	var prev *Node[int]
+	node := list.head.next[0]
+	for i, value := range expected {
+		if node == nil || node.value != value {
+			t.Fatalf(`Expected %v at rank %v`, value, i)
+		}
+		if node.prev != prev {
+			t.Fatalf(`Broken prev link at rank %v`, i)
+		}
+		if len(node.next) > list.level {
+			t.Fatalf(`Node at rank %v has %v levels but list uses %v`, i, len(node.next), list.level)
+		}
+		rank[node] = uint(i + 1)
+		prev = node
+		node = node.next[0]
+	}
+	if node != nil || list.tail != prev {
+		t.Fatalf(`Expected tail at rank %v`, len(expected)-1)
+	}
+
+	for node := range rank {
+		for i := 0; i < len(node.next) && i < list.level; i++ {
+			if next := node.next[i]; next != nil && node.span[i] != rank[next]-rank[node] {
+				t.Fatalf(`Span %v at level %v should be %v`, node.span[i], i, rank[next]-rank[node])
+			}
+		}
+	}
+}
+
+func Test_Skip_List_matches_sorted_slice_model(t *testing.T) {
+	list := New_Skip_List[int](seeded())
+	model := []int{}
+	rng := rand.New(rand.NewPCG(3, 4))
+
+	for step := 0; step < 2000; step++ {
+		value := rng.IntN(300)
+		index, present := slices.BinarySearch(model, value)
+
+		if rng.IntN(3) == 0 {
+			removed, err := list.Delete(value)
+			if present != (err == nil) {
+				t.Fatalf(`Delete %v returned %v but present is %v`, value, err, present)
+			}
+			if present {
+				model = slices.Delete(model, index, index+1)
+				if removed != value {
+					t.Fatalf(`Expected to delete %v but got %v`, value, removed)
+				}
+			}
+		} else {
+			if list.Insert(value) == present {
+				t.Fatalf(`Insert %v reported wrong result, present is %v`, value, present)
+			}
+			if !present {
+				model = slices.Insert(model, index, value)
+			}
+		}
+
+		if step%100 == 0 {
+			checkInvariants(t, list, model)
+		}
+	}
+	checkInvariants(t, list, model)
+
+	for index, value := range model {
+		if got, err := list.Get(uint(index)); err != nil || got != value {
+			t.Fatalf(`Expected Get(%v) of %v but got %v, %v`, index, value, got, err)
+		}
+		if rank, err := list.Rank(value); err != nil || rank != uint(index) {
+			t.Fatalf(`Expected Rank(%v) of %v but got %v, %v`, value, index, rank, err)
+		}
+	}
+}
+
+func Test_Skip_List_same_seed_builds_same_levels(t *testing.T) {
+	levels := func() []int {
+		list := New_Skip_List[int](seeded())
+		for i := 0; i < 200; i++ {
+			list.Insert(i)
+		}
+		result := []int{}
+		for node := list.head.next[0]; node != nil; node = node.next[0] {
+			result = append(result, len(node.next))
+		}
+		return result
+	}
+
+	if first, second := levels(), levels(); !slices.Equal(first, second) {
+		t.Fatalf(`Expected identical levels for identical seeds`)
+	}
+}
+
+func Test_Floor_and_Ceiling(t *testing.T) {
+	list := New_Skip_List[int](seeded())
+	for _, value := range []int{10, 20, 30, 40} {
+		list.Insert(value)
+	}
+
+	cases := []struct {
+		item    int
+		floor   int
+		ceiling int
+	}{
+		{10, 10, 10},
+		{15, 10, 20},
+		{25, 20, 30},
+		{40, 40, 40},
+	}
+	for _, c := range cases {
+		if floor, err := list.Floor(c.item); err != nil || floor != c.floor {
+			t.Fatalf(`Expected Floor(%v) of %v but got %v, %v`, c.item, c.floor, floor, err)
+		}
+		if ceiling, err := list.Ceiling(c.item); err != nil || ceiling != c.ceiling {
+			t.Fatalf(`Expected Ceiling(%v) of %v but got %v, %v`, c.item, c.ceiling, ceiling, err)
+		}
+	}
+
+	if _, err := list.Floor(5); !errors.Is(err, common_errors.ErrNotFound) {
+		t.Fatalf(`Expected ErrNotFound for Floor below smallest but got %v`, err)
+	}
+	if _, err := list.Ceiling(45); !errors.Is(err, common_errors.ErrNotFound) {
+		t.Fatalf(`Expected ErrNotFound for Ceiling above largest but got %v`, err)
+	}
+}
+
+func Test_Skip_List_errors(t *testing.T) {
+	list := New_Skip_List[int](seeded())
+
+	if _, err := list.First(); !errors.Is(err, common_errors.ErrEmpty) {
+		t.Fatalf(`Expected ErrEmpty from First but got %v`, err)
+	}
+	if _, err := list.Get(0); !errors.Is(err, common_errors.ErrEmpty) {
+		t.Fatalf(`Expected ErrEmpty from Get but got %v`, err)
+	}
+
+	list.Insert(1)
+	list.Insert(2)
+
+	_, err := list.Get(2)
+	var index_err *common_errors.Index_Error
+	if !errors.As(err, &index_err) || index_err.Index != 2 || index_err.Length != 2 {
+		t.Fatalf(`Expected *Index_Error for index 2 and length 2 but got %v`, err)
+	}
+	if _, err := list.Delete(3); !errors.Is(err, common_errors.ErrNotFound) {
+		t.Fatalf(`Expected ErrNotFound from Delete but got %v`, err)
+	}
+	if _, err := list.Rank(3); !errors.Is(err, common_errors.ErrNotFound) {
+		t.Fatalf(`Expected ErrNotFound from Rank but got %v`, err)
+	}
+}
+
+func Test_RemoveAt_First_and_Last(t *testing.T) {
+	list := New_Skip_List[int](seeded())
+	for i := 0; i < 10; i++ {
+		list.Insert(i)
+	}
+
+	if value, err := list.RemoveAt(4); err != nil || value != 4 {
+		t.Fatalf(`Expected to remove 4 but got %v, %v`, value, err)
+	}
+	if first, _ := list.First(); first != 0 {
+		t.Fatalf(`Expected First of 0 but got %v`, first)
+	}
+	if last, _ := list.Last(); last != 9 {
+		t.Fatalf(`Expected Last of 9 but got %v`, last)
+	}
+	checkInvariants(t, list, []int{0, 1, 2, 3, 5, 6, 7, 8, 9})
+}
+
+func Test_New_Skip_List_Func_orders_by_key(t *testing.T) {
+	type entry struct {
+		key   string
+		value int
+	}
+	list := New_Skip_List_Func(func(a, b entry) int {
+		return strings.Compare(a.key, b.key)
+	}, nil)
+
+	list.Insert(entry{"b", 2})
+	list.Insert(entry{"a", 1})
+	if list.Insert(entry{"a", 9}) {
+		t.Fatalf(`Expected Insert of equal key to be refused`)
+	}
+
+	found, err := list.Find(entry{key: "a"})
+	if err != nil || found.value != 1 {
+		t.Fatalf(`Expected to find value 1 for "a" but got %v, %v`, found, err)
+	}
+}
+
+func Benchmark_Skip_List_Insert_random(b *testing.B) {
+	list := New_Skip_List[int](seeded())
+	rng := rand.New(rand.NewPCG(3, 4))
+	for i := 0; i < b.N; i++ {
+		list.Insert(rng.Int())
+	}
+}
+
+func Benchmark_Skip_List_Contains(b *testing.B) {
+	list := New_Skip_List[int](seeded())
+	for i := 0; i < 100000; i++ {
+		list.Insert(i)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		list.Contains(i % 100000)
+	}
+}
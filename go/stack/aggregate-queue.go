@@ -0,0 +1,172 @@
+package stack
+
+import (
+	"cmp"
+	common_errors "common-errors"
+)
+
+// First-in first-out queue made of two `Aggregate_Stack`s, answers the
+// combination of every queued value in amortized `O(1)`, which is what a
+// sliding window fold needs
+//
+// ## Example
+//
+//	window := New_Aggregate_Queue(gcd)
+//	for _, value := range values {
+//		window.Enqueue(value)
+//		if window.Length > width {
+//			window.Deque()
+//		}
+//		result, _ := window.Aggregate()
+//	}
+//
+// @notes
+//
+// - New items are pushed onto `in`, `out` is refilled from `in` only when it
+// runs dry, so each item moves between stacks once
+// - `out` folds with arguments flipped so the result is always ordered oldest
+// to newest, which matters for non-commutative `combine`
+// - Zero value works as a plain queue, `Aggregate` returns `ErrNoCombine`
+type Aggregate_Queue[T any] struct {
+	Length  uint
+	in      Aggregate_Stack[T]
+	out     Aggregate_Stack[T]
+	combine func(older, newer T) T
+}
+
+func New_Aggregate_Queue[T any](combine func(older, newer T) T) *Aggregate_Queue[T] {
+	queue := &Aggregate_Queue[T]{
+		combine: combine,
+	}
+	queue.in.combine = combine
+	queue.out.combine = func(below, above T) T { return combine(above, below) }
+	return queue
+}
+
+/**
+ * Appends item to end of queue
+ */
+func (queue *Aggregate_Queue[T]) Enqueue(item T) {
+	queue.in.Push(item)
+	queue.Length++
+}
+
+/**
+ * Removes and returns first item of queue or an error
+ */
+func (queue *Aggregate_Queue[T]) Deque() (T, error) {
+	if queue.Length == 0 {
+		var result T
+		return result, &common_errors.Empty_Error{Container: "Queue"}
+	}
+
+	queue.refill()
+	queue.Length--
+	return queue.out.Pop()
+}
+
+/**
+ * Returns first value of queue without mutation
+ */
+func (queue *Aggregate_Queue[T]) Peek() (T, error) {
+	if queue.Length == 0 {
+		var result T
+		return result, &common_errors.Empty_Error{Container: "Queue"}
+	}
+
+	queue.refill()
+	return queue.out.Peek()
+}
+
+/**
+ * Returns combination of every queued value, oldest to newest, or an error
+ */
+func (queue *Aggregate_Queue[T]) Aggregate() (T, error) {
+	var result T
+	if queue.combine == nil {
+		return result, ErrNoCombine
+	}
+	if queue.Length == 0 {
+		return result, &common_errors.Empty_Error{Container: "Queue"}
+	}
+
+	if queue.out.Length == 0 {
+		return queue.in.Aggregate()
+	}
+	older, _ := queue.out.Aggregate()
+	if queue.in.Length == 0 {
+		return older, nil
+	}
+	newer, _ := queue.in.Aggregate()
+	return queue.combine(older, newer), nil
+}
+
+// Reverse `in` onto `out` once `out` is empty
+func (queue *Aggregate_Queue[T]) refill() {
+	if queue.out.Length > 0 {
+		return
+	}
+
+	for queue.in.Length > 0 {
+		item, _ := queue.in.Pop()
+		queue.out.Push(item)
+	}
+}
+
+// `Aggregate_Queue` of min/max pairs, so a sliding window can report its
+// smallest and largest value in amortized `O(1)`
+//
+// @note - zero value is ready to use
+type Min_Max_Queue[T cmp.Ordered] struct {
+	Length uint
+	window *Aggregate_Queue[min_max[T]]
+}
+
+/**
+ * Appends item to end of queue
+ */
+func (queue *Min_Max_Queue[T]) Enqueue(item T) {
+	queue.aggregate().Enqueue(min_max[T]{min: item, max: item})
+	queue.Length = queue.window.Length
+}
+
+/**
+ * Removes and returns first item of queue or an error
+ */
+func (queue *Min_Max_Queue[T]) Deque() (T, error) {
+	item, err := queue.aggregate().Deque()
+	queue.Length = queue.window.Length
+	return item.min, err
+}
+
+/**
+ * Returns first value of queue without mutation
+ */
+func (queue *Min_Max_Queue[T]) Peek() (T, error) {
+	item, err := queue.aggregate().Peek()
+	return item.min, err
+}
+
+/**
+ * Returns smallest queued value, or an error when empty
+ */
+func (queue *Min_Max_Queue[T]) Min() (T, error) {
+	extremes, err := queue.aggregate().Aggregate()
+	return extremes.min, err
+}
+
+/**
+ * Returns largest queued value, or an error when empty
+ */
+func (queue *Min_Max_Queue[T]) Max() (T, error) {
+	extremes, err := queue.aggregate().Aggregate()
+	return extremes.max, err
+}
+
+// Create underlying queue on first use so the zero value is usable
+func (queue *Min_Max_Queue[T]) aggregate() *Aggregate_Queue[min_max[T]] {
+	if queue.window == nil {
+		queue.window = New_Aggregate_Queue(combineMinMax[T])
+	}
+	return queue.window
+}
@@ -0,0 +1,184 @@
+package stack
+
+import (
+	"cmp"
+	common_errors "common-errors"
+	"errors"
+)
+
+// Returned by `Aggregate` of a stack or queue built without a combine function
+var ErrNoCombine = errors.New("No combine function, use the constructor")
+
+type aggregate_frame[T any] struct {
+	value     T
+	aggregate T
+}
+
+// Stack that remembers, per frame, the combination of every value at or
+// below it so `Aggregate` is `O(1)` no matter how many pushes and pops
+//
+// ## Example
+//
+//	sums := New_Aggregate_Stack(func(below, above int) int { return below + above })
+//	sums.Push(3)
+//	sums.Push(4)
+//	total, _ := sums.Aggregate() // 7
+//	sums.Pop()
+//	total, _ = sums.Aggregate()  // 3
+//
+// @notes
+//
+// - `combine` must be associative, such as sum, product, gcd, min or max
+// - Zero value works as a plain stack, `Aggregate` returns `ErrNoCombine`
+type Aggregate_Stack[T any] struct {
+	Length  uint
+	frames  Stack[aggregate_frame[T]]
+	combine func(below, above T) T
+}
+
+func New_Aggregate_Stack[T any](combine func(below, above T) T) *Aggregate_Stack[T] {
+	return &Aggregate_Stack[T]{
+		combine: combine,
+	}
+}
+
+/**
+ * Push item onto top of stack and fold it into running aggregate
+ */
+func (stack *Aggregate_Stack[T]) Push(item T) {
+	frame := aggregate_frame[T]{
+		value:     item,
+		aggregate: item,
+	}
+
+	if below, err := stack.frames.Peek(); err == nil && stack.combine != nil {
+		frame.aggregate = stack.combine(below.aggregate, item)
+	}
+
+	stack.frames.Push(frame)
+	stack.Length = stack.frames.Length
+}
+
+/**
+ * Remove and return top of stack, or an error
+ */
+func (stack *Aggregate_Stack[T]) Pop() (T, error) {
+	frame, err := stack.frames.Pop()
+	stack.Length = stack.frames.Length
+	return frame.value, err
+}
+
+/**
+ * Returns top value of stack without mutation
+ */
+func (stack *Aggregate_Stack[T]) Peek() (T, error) {
+	if stack.Length == 0 {
+		var result T
+		return result, &common_errors.Empty_Error{Container: "Stack"}
+	}
+	frame, _ := stack.frames.Peek()
+	return frame.value, nil
+}
+
+/**
+ * Returns number of items, same as reading `Length`, to satisfy `Interface`
+ */
+func (stack *Aggregate_Stack[T]) Size() uint {
+	return stack.Length
+}
+
+/**
+ * Returns combination of every value in stack, bottom to top, or an error
+ */
+func (stack *Aggregate_Stack[T]) Aggregate() (T, error) {
+	var result T
+	if stack.combine == nil {
+		return result, ErrNoCombine
+	}
+	if stack.Length == 0 {
+		return result, &common_errors.Empty_Error{Container: "Stack"}
+	}
+	frame, _ := stack.frames.Peek()
+	return frame.aggregate, nil
+}
+
+// Smallest and largest of a run of values, a single value is both
+type min_max[T cmp.Ordered] struct {
+	min T
+	max T
+}
+
+func combineMinMax[T cmp.Ordered](a, b min_max[T]) min_max[T] {
+	return min_max[T]{
+		min: min(a.min, b.min),
+		max: max(a.max, b.max),
+	}
+}
+
+// Stack answering `Min` and `Max` of all items in `O(1)`, an `Aggregate_Stack`
+// of min/max pairs
+//
+// @note - zero value is ready to use
+type Min_Max_Stack[T cmp.Ordered] struct {
+	Length uint
+	frames Aggregate_Stack[min_max[T]]
+}
+
+/**
+ * Push item onto top of stack and update running min and max
+ */
+func (stack *Min_Max_Stack[T]) Push(item T) {
+	// Set on first use so the zero value is usable
+	if stack.frames.combine == nil {
+		stack.frames.combine = combineMinMax[T]
+	}
+	stack.frames.Push(min_max[T]{min: item, max: item})
+	stack.Length = stack.frames.Length
+}
+
+/**
+ * Remove and return top of stack, or an error
+ */
+func (stack *Min_Max_Stack[T]) Pop() (T, error) {
+	frame, err := stack.frames.Pop()
+	stack.Length = stack.frames.Length
+	return frame.min, err
+}
+
+/**
+ * Returns top value of stack without mutation
+ */
+func (stack *Min_Max_Stack[T]) Peek() (T, error) {
+	frame, err := stack.frames.Peek()
+	return frame.min, err
+}
+
+/**
+ * Returns number of items, same as reading `Length`, to satisfy `Interface`
+ */
+func (stack *Min_Max_Stack[T]) Size() uint {
+	return stack.Length
+}
+
+/**
+ * Returns smallest value in stack, or an error when empty
+ */
+func (stack *Min_Max_Stack[T]) Min() (T, error) {
+	extremes, err := stack.extremes()
+	return extremes.min, err
+}
+
+/**
+ * Returns largest value in stack, or an error when empty
+ */
+func (stack *Min_Max_Stack[T]) Max() (T, error) {
+	extremes, err := stack.extremes()
+	return extremes.max, err
+}
+
+func (stack *Min_Max_Stack[T]) extremes() (min_max[T], error) {
+	if stack.Length == 0 {
+		return min_max[T]{}, &common_errors.Empty_Error{Container: "Stack"}
+	}
+	return stack.frames.Aggregate()
+}
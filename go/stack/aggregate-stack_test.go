@@ -0,0 +1,205 @@
+package stack
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	common_errors "common-errors"
+)
+
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+func Test_Aggregate_Stack_tracks_running_sum(t *testing.T) {
+	stack := New_Aggregate_Stack(func(below, above int) int { return below + above })
+
+	if _, err := stack.Aggregate(); !errors.Is(err, common_errors.ErrEmpty) {
+		t.Fatalf(`Expected ErrEmpty for empty stack but got %v`, err)
+	}
+
+	expected := []int{0}
+	for i := 1; i <= 10; i++ {
+		stack.Push(i)
+		expected = append(expected, expected[len(expected)-1]+i)
+
+		sum, err := stack.Aggregate()
+		if err != nil || sum != expected[i] {
+			t.Fatalf(`Expected sum %v but got %v with error %v`, expected[i], sum, err)
+		}
+	}
+
+	for i := 10; i > 1; i-- {
+		value, err := stack.Pop()
+		if err != nil || value != i {
+			t.Fatalf(`Expected popped value %v but got %v with error %v`, i, value, err)
+		}
+
+		sum, _ := stack.Aggregate()
+		if sum != expected[i-1] {
+			t.Fatalf(`Expected sum %v after pop but got %v`, expected[i-1], sum)
+		}
+	}
+}
+
+func Test_Aggregate_Stack_tracks_running_gcd(t *testing.T) {
+	stack := New_Aggregate_Stack(gcd)
+
+	stack.Push(36)
+	stack.Push(24)
+	stack.Push(10)
+
+	result, _ := stack.Aggregate()
+	if result != 2 {
+		t.Fatalf(`Expected gcd 2 but got %v`, result)
+	}
+
+	stack.Pop()
+	result, _ = stack.Aggregate()
+	if result != 12 {
+		t.Fatalf(`Expected gcd 12 after pop but got %v`, result)
+	}
+}
+
+func Test_Min_Max_Stack_tracks_extremes_through_pushes_and_pops(t *testing.T) {
+	stack := Min_Max_Stack[int]{}
+
+	if _, err := stack.Min(); !errors.Is(err, common_errors.ErrEmpty) {
+		t.Fatalf(`Expected ErrEmpty for empty stack but got %v`, err)
+	}
+	if _, err := stack.Max(); !errors.Is(err, common_errors.ErrEmpty) {
+		t.Fatalf(`Expected ErrEmpty for empty stack but got %v`, err)
+	}
+
+	values := []int{5, 3, 8, 1, 9, 2}
+	mins := []int{5, 3, 3, 1, 1, 1}
+	maxs := []int{5, 5, 8, 8, 9, 9}
+
+	for i, value := range values {
+		stack.Push(value)
+		low, _ := stack.Min()
+		high, _ := stack.Max()
+		if low != mins[i] || high != maxs[i] {
+			t.Fatalf(`After push %v expected min %v max %v but got %v %v`, i, mins[i], maxs[i], low, high)
+		}
+	}
+
+	for i := len(values) - 1; i > 0; i-- {
+		stack.Pop()
+		low, _ := stack.Min()
+		high, _ := stack.Max()
+		if low != mins[i-1] || high != maxs[i-1] {
+			t.Fatalf(`After pop expected min %v max %v but got %v %v`, mins[i-1], maxs[i-1], low, high)
+		}
+	}
+}
+
+func Test_Aggregate_Stacks_satisfy_Interface(t *testing.T) {
+	testInterfaceContract(t, New_Aggregate_Stack(func(below, above uint) uint { return below + above }))
+	testInterfaceContract(t, &Min_Max_Stack[uint]{})
+}
+
+func Test_Aggregate_Queue_keeps_oldest_to_newest_order(t *testing.T) {
+	// Concatenation is associative but not commutative, so order shows
+	queue := New_Aggregate_Queue(func(older, newer string) string { return older + newer })
+
+	for _, letter := range []string{"a", "b", "c"} {
+		queue.Enqueue(letter)
+	}
+
+	// Forces refill so values are spread across both stacks
+	queue.Deque()
+	queue.Enqueue("d")
+	queue.Enqueue("e")
+
+	result, err := queue.Aggregate()
+	if err != nil || result != "bcde" {
+		t.Fatalf(`Expected "bcde" but got %v with error %v`, result, err)
+	}
+
+	for _, expected := range []string{"b", "c", "d", "e"} {
+		peek, _ := queue.Peek()
+		value, err := queue.Deque()
+		if err != nil || value != expected || peek != expected {
+			t.Fatalf(`Expected %v but got %v and peeked %v with error %v`, expected, value, peek, err)
+		}
+	}
+
+	if _, err := queue.Aggregate(); !errors.Is(err, common_errors.ErrEmpty) {
+		t.Fatalf(`Expected ErrEmpty for empty queue but got %v`, err)
+	}
+}
+
+func Test_Min_Max_Queue_answers_sliding_window_extremes(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	values := make([]int, 500)
+	for i := range values {
+		values[i] = random.Intn(1000)
+	}
+
+	width := 7
+	window := Min_Max_Queue[int]{}
+
+	for i, value := range values {
+		window.Enqueue(value)
+		if window.Length > uint(width) {
+			window.Deque()
+		}
+
+		start := max(0, i-width+1)
+		expected_min, expected_max := values[start], values[start]
+		for _, v := range values[start : i+1] {
+			expected_min = min(expected_min, v)
+			expected_max = max(expected_max, v)
+		}
+
+		low, _ := window.Min()
+		high, _ := window.Max()
+		if low != expected_min || high != expected_max {
+			t.Fatalf(`Window ending %v expected min %v max %v but got %v %v`, i, expected_min, expected_max, low, high)
+		}
+	}
+}
+
+func Test_Aggregate_zero_values_work_as_plain_containers(t *testing.T) {
+	stack := Aggregate_Stack[int]{}
+	stack.Push(1)
+	stack.Push(2)
+	if value, err := stack.Pop(); err != nil || value != 2 {
+		t.Fatalf(`Expected 2 but got %v with error %v`, value, err)
+	}
+	if _, err := stack.Aggregate(); !errors.Is(err, ErrNoCombine) {
+		t.Fatalf(`Expected ErrNoCombine but got %v`, err)
+	}
+
+	queue := Aggregate_Queue[int]{}
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	if value, err := queue.Deque(); err != nil || value != 1 {
+		t.Fatalf(`Expected 1 but got %v with error %v`, value, err)
+	}
+	if _, err := queue.Aggregate(); !errors.Is(err, ErrNoCombine) {
+		t.Fatalf(`Expected ErrNoCombine but got %v`, err)
+	}
+}
+
+func Test_Min_Max_Queue_zero_value_reports_empty(t *testing.T) {
+	queue := Min_Max_Queue[int]{}
+
+	if _, err := queue.Min(); !errors.Is(err, common_errors.ErrEmpty) {
+		t.Fatalf(`Expected ErrEmpty but got %v`, err)
+	}
+	if _, err := queue.Deque(); !errors.Is(err, common_errors.ErrEmpty) {
+		t.Fatalf(`Expected ErrEmpty but got %v`, err)
+	}
+
+	queue.Enqueue(4)
+	queue.Enqueue(1)
+	if value, err := queue.Peek(); err != nil || value != 4 || queue.Length != 2 {
+		t.Fatalf(`Expected 4 of 2 items but got %v of %v with error %v`, value, queue.Length, err)
+	}
+}
@@ -0,0 +1,40 @@
+package stack
+
+import "context"
+
+/**
+ * Push every value received from channel, returns once channel is closed
+ */
+func (stack *Stack[T]) From_Channel(channel <-chan T) {
+	for item := range channel {
+		stack.Push(item)
+	}
+}
+
+/**
+ * Drain stack, last pushed first, into returned channel
+ *
+ * Channel is closed once stack is empty or ctx is done, so the background
+ * goroutine always exits even when the reader stops early
+ *
+ * @note - an item is only popped after it was received, so cancelling never
+ * drops a value, and stack must not be touched until channel is closed
+ */
+func (stack *Stack[T]) To_Channel(ctx context.Context) <-chan T {
+	channel := make(chan T)
+
+	go func() {
+		defer close(channel)
+		for stack.Length > 0 {
+			item, _ := stack.Peek()
+			select {
+			case channel <- item:
+				stack.Pop()
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return channel
+}
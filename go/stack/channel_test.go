@@ -0,0 +1,76 @@
+package stack
+
+import (
+	"context"
+	"testing"
+)
+
+func Test_From_Channel_pushes_until_channel_closes(t *testing.T) {
+	stack := Stack[int]{}
+
+	channel := make(chan int)
+	go func() {
+		defer close(channel)
+		for i := 0; i < 5; i++ {
+			channel <- i
+		}
+	}()
+
+	stack.From_Channel(channel)
+
+	if stack.Length != 5 {
+		t.Fatalf(`Expected stack.Length of 5 but got %v`, stack.Length)
+	}
+
+	peek, err := stack.Peek()
+	if err == nil && peek != 4 {
+		t.Fatalf(`Expected last received value 4 on top but got %v`, peek)
+	}
+}
+
+func Test_To_Channel_drains_last_in_first_out(t *testing.T) {
+	stack := Stack[int]{}
+	for i := 0; i < 5; i++ {
+		stack.Push(i)
+	}
+
+	expected := 4
+	for value := range stack.To_Channel(context.Background()) {
+		if value != expected {
+			t.Fatalf(`Expected value %v but got %v`, expected, value)
+		}
+		expected--
+	}
+
+	if stack.Length != 0 {
+		t.Fatalf(`Expected empty stack but got length %v`, stack.Length)
+	}
+}
+
+func Test_To_Channel_closes_on_cancel_without_dropping_values(t *testing.T) {
+	stack := Stack[int]{}
+	for i := 0; i < 5; i++ {
+		stack.Push(i)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	channel := stack.To_Channel(ctx)
+
+	received := []int{<-channel}
+	cancel()
+
+	// Channel closing proves the goroutine returned, a few more values may
+	// slip through while select picks between sending and ctx being done
+	for value := range channel {
+		received = append(received, value)
+	}
+
+	if stack.Length+uint(len(received)) != 5 {
+		t.Fatalf(`Expected received %v plus remaining %v to account for 5 values`, received, stack.Length)
+	}
+
+	peek, err := stack.Peek()
+	if err == nil && peek != 4-len(received) {
+		t.Fatalf(`Expected top of stack to be %v but got %v`, 4-len(received), peek)
+	}
+}
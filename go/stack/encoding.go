@@ -0,0 +1,97 @@
+package stack
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+)
+
+// Items from bottom to top, the order they were pushed, so decoding by
+// pushing each in turn rebuilds the same stack
+func (stack Stack[T]) items() []T {
+	items := make([]T, stack.Length)
+	node := stack.head
+	for i := int(stack.Length) - 1; i >= 0; i-- {
+		items[i] = node.value
+		node = node.prev
+	}
+	return items
+}
+
+func (stack *Stack[T]) reset(items []T) {
+	*stack = Stack[T]{}
+	for _, item := range items {
+		stack.Push(item)
+	}
+}
+
+/**
+ * Encode as JSON array from bottom to top, so `[1,2,3]` has `3` on top
+ */
+func (stack Stack[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(stack.items())
+}
+
+/**
+ * Replace contents with JSON array ordered from bottom to top
+ */
+func (stack *Stack[T]) UnmarshalJSON(data []byte) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("Stack expects a JSON array: %w", err)
+	}
+
+	items := make([]T, len(raw))
+	for i, message := range raw {
+		if err := json.Unmarshal(message, &items[i]); err != nil {
+			return fmt.Errorf("Cannot decode stack item %d: %w", i, err)
+		}
+	}
+
+	stack.reset(items)
+	return nil
+}
+
+/**
+ * Encode with `encoding/gob` as item count followed by items from bottom to top
+ */
+func (stack Stack[T]) MarshalBinary() ([]byte, error) {
+	buffer := bytes.Buffer{}
+	encoder := gob.NewEncoder(&buffer)
+
+	if err := encoder.Encode(stack.Length); err != nil {
+		return nil, err
+	}
+	for i, item := range stack.items() {
+		if err := encoder.Encode(item); err != nil {
+			return nil, fmt.Errorf("Cannot encode stack item %d: %w", i, err)
+		}
+	}
+
+	return buffer.Bytes(), nil
+}
+
+/**
+ * Replace contents with data produced by `MarshalBinary`
+ */
+func (stack *Stack[T]) UnmarshalBinary(data []byte) error {
+	decoder := gob.NewDecoder(bytes.NewReader(data))
+
+	var length uint
+	if err := decoder.Decode(&length); err != nil {
+		return fmt.Errorf("Cannot decode stack length: %w", err)
+	}
+
+	items := []T{}
+	for i := uint(0); i < length; i++ {
+		var item T
+		if err := decoder.Decode(&item); err != nil {
+			return fmt.Errorf("Cannot decode stack item %d of %d: %w", i, length, err)
+		}
+		items = append(items, item)
+	}
+
+	stack.reset(items)
+	return nil
+}
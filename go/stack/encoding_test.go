@@ -0,0 +1,105 @@
+package stack
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func Test_Stack_JSON_round_trip_keeps_LIFO_order(t *testing.T) {
+	stack := Stack[uint]{}
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+
+	data, err := json.Marshal(stack)
+	if err != nil {
+		t.Fatalf(`Unexpected error %v`, err)
+	}
+	if string(data) != "[1,2,3]" {
+		t.Fatalf(`Expected [1,2,3] but got %s`, data)
+	}
+
+	decoded := Stack[uint]{}
+	decoded.Push(99)
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf(`Unexpected error %v`, err)
+	}
+	if decoded.Length != 3 {
+		t.Fatalf(`Expected length 3 but got %v`, decoded.Length)
+	}
+
+	for _, expected := range []uint{3, 2, 1} {
+		if value, _ := decoded.Pop(); value != expected {
+			t.Fatalf(`Expected %v but got %v`, expected, value)
+		}
+	}
+}
+
+func Test_Stack_JSON_empty(t *testing.T) {
+	data, _ := json.Marshal(&Stack[string]{})
+	if string(data) != "[]" {
+		t.Fatalf(`Expected [] but got %s`, data)
+	}
+
+	decoded := Stack[string]{}
+	if err := json.Unmarshal([]byte("[]"), &decoded); err != nil || decoded.Length != 0 {
+		t.Fatalf(`Expected empty stack but got %v, %v`, decoded.Length, err)
+	}
+}
+
+func Test_Stack_UnmarshalJSON_reports_bad_item(t *testing.T) {
+	decoded := Stack[uint]{}
+
+	err := json.Unmarshal([]byte(`[1, "two", 3]`), &decoded)
+	if err == nil || !strings.Contains(err.Error(), "item 1") {
+		t.Fatalf(`Expected error naming item 1 but got %v`, err)
+	}
+
+	err = json.Unmarshal([]byte(`{"a": 1}`), &decoded)
+	if err == nil || !strings.Contains(err.Error(), "JSON array") {
+		t.Fatalf(`Expected error about JSON array but got %v`, err)
+	}
+}
+
+func Test_Stack_binary_round_trip_keeps_LIFO_order(t *testing.T) {
+	type point struct{ X, Y int }
+
+	stack := Stack[point]{}
+	stack.Push(point{1, 2})
+	stack.Push(point{3, 4})
+
+	data, err := stack.MarshalBinary()
+	if err != nil {
+		t.Fatalf(`Unexpected error %v`, err)
+	}
+
+	decoded := Stack[point]{}
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Fatalf(`Unexpected error %v`, err)
+	}
+	if decoded.Length != 2 {
+		t.Fatalf(`Expected length 2 but got %v`, decoded.Length)
+	}
+	if top, _ := decoded.Pop(); top != (point{3, 4}) {
+		t.Fatalf(`Expected {3 4} on top but got %v`, top)
+	}
+}
+
+func Test_Stack_UnmarshalBinary_reports_bad_data(t *testing.T) {
+	stack := Stack[uint]{}
+	stack.Push(1)
+	stack.Push(2)
+	data, _ := stack.MarshalBinary()
+
+	decoded := Stack[uint]{}
+	err := decoded.UnmarshalBinary(data[:len(data)-1])
+	if err == nil || !strings.Contains(err.Error(), "item 1 of 2") {
+		t.Fatalf(`Expected error naming item 1 of 2 but got %v`, err)
+	}
+
+	wrong := Stack[string]{}
+	if err := wrong.UnmarshalBinary(data); err == nil {
+		t.Fatalf(`Expected error decoding numbers as strings`)
+	}
+}
@@ -0,0 +1,41 @@
+package stack
+
+import "iter"
+
+/**
+ * Iterate values from top to bottom without removing them
+ *
+ * @note - stack must not be changed while iterating
+ */
+func (stack *Stack[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := stack.head; node != nil; node = node.prev {
+			if !yield(node.value) {
+				return
+			}
+		}
+	}
+}
+
+/**
+ * Pop and yield values until stack is empty, stopping early leaves the rest
+ */
+func (stack *Stack[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for stack.Length > 0 {
+			value, _ := stack.Pop()
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+/**
+ * Push every value of sequence in order, so last value ends up on top
+ */
+func (stack *Stack[T]) Push_All(seq iter.Seq[T]) {
+	for value := range seq {
+		stack.Push(value)
+	}
+}
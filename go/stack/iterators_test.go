@@ -0,0 +1,38 @@
+package stack
+
+import (
+	"slices"
+	"testing"
+)
+
+func Test_Stack_Values_does_not_consume(t *testing.T) {
+	stack := Stack[int]{}
+	stack.Push_All(slices.Values([]int{1, 2, 3}))
+
+	if values := slices.Collect(stack.Values()); !slices.Equal(values, []int{3, 2, 1}) {
+		t.Fatalf(`Expected [3 2 1] but got %v`, values)
+	}
+	if stack.Length != 3 {
+		t.Fatalf(`Expected length 3 but got %v`, stack.Length)
+	}
+}
+
+func Test_Stack_Drain_consumes_and_stops_early(t *testing.T) {
+	stack := Stack[int]{}
+	stack.Push_All(slices.Values([]int{1, 2, 3, 4}))
+
+	drained := []int{}
+	for value := range stack.Drain() {
+		drained = append(drained, value)
+		if value == 3 {
+			break
+		}
+	}
+	if !slices.Equal(drained, []int{4, 3}) || stack.Length != 2 {
+		t.Fatalf(`Expected [4 3] drained and 2 left but got %v and %v`, drained, stack.Length)
+	}
+
+	if values := slices.Collect(stack.Drain()); !slices.Equal(values, []int{2, 1}) || stack.Length != 0 {
+		t.Fatalf(`Expected [2 1] drained but got %v`, values)
+	}
+}
@@ -0,0 +1,107 @@
+package stack
+
+import (
+	"iter"
+
+	common_errors "common-errors"
+)
+
+type persistent_node[T any] struct {
+	value T
+	prev  *persistent_node[T]
+}
+
+// Immutable stack, `Push` and `Pop` return new versions sharing their tail
+// with the receiver, so earlier versions stay valid for backtracking
+//
+// ## Example
+//
+//	empty := Persistent_Stack[int]{}
+//	one := empty.Push(1)
+//	two := one.Push(2)
+//	value, rest, err := two.Pop() // 2, stack holding 1, nil
+//	one.Length() == 1               // older version is unchanged
+//
+// @notes
+//
+// - Every operation is `O(1)` except `Reverse` and iteration
+// - Nothing is ever mutated, so values are safe to share between goroutines
+// without locks
+type Persistent_Stack[T any] struct {
+	length uint
+	head   *persistent_node[T]
+}
+
+/**
+ * Returns number of items, kept unexported so it always matches the nodes
+ */
+func (stack Persistent_Stack[T]) Length() uint {
+	return stack.length
+}
+
+/**
+ * Returns new stack with item on top
+ */
+func (stack Persistent_Stack[T]) Push(item T) Persistent_Stack[T] {
+	return Persistent_Stack[T]{
+		length: stack.length + 1,
+		head: &persistent_node[T]{
+			value: item,
+			prev:  stack.head,
+		},
+	}
+}
+
+/**
+ * Returns top item and new stack without it, or an error
+ */
+func (stack Persistent_Stack[T]) Pop() (T, Persistent_Stack[T], error) {
+	if stack.head == nil {
+		var result T
+		return result, stack, &common_errors.Empty_Error{Container: "Stack"}
+	}
+
+	rest := Persistent_Stack[T]{
+		length: stack.length - 1,
+		head:   stack.head.prev,
+	}
+
+	return stack.head.value, rest, nil
+}
+
+/**
+ * Returns top value of stack
+ */
+func (stack Persistent_Stack[T]) Peek() (T, error) {
+	if stack.head == nil {
+		var result T
+		return result, &common_errors.Empty_Error{Container: "Stack"}
+	}
+
+	return stack.head.value, nil
+}
+
+/**
+ * Returns new stack with items in opposite order, the old top at the bottom
+ */
+func (stack Persistent_Stack[T]) Reverse() Persistent_Stack[T] {
+	result := Persistent_Stack[T]{}
+	for node := stack.head; node != nil; node = node.prev {
+		result = result.Push(node.value)
+	}
+	return result
+}
+
+/**
+ * Iterate values from top to bottom, versions never change so iterating is
+ * always safe
+ */
+func (stack Persistent_Stack[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := stack.head; node != nil; node = node.prev {
+			if !yield(node.value) {
+				return
+			}
+		}
+	}
+}
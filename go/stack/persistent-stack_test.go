@@ -0,0 +1,130 @@
+package stack
+
+import (
+	"slices"
+	"sync"
+	"testing"
+)
+
+func persistentValues[T any](stack Persistent_Stack[T]) []T {
+	return slices.Collect(stack.Values())
+}
+
+func Test_Persistent_Stack_errors_when_empty(t *testing.T) {
+	stack := Persistent_Stack[uint]{}
+
+	if _, err := stack.Peek(); err == nil {
+		t.Fatalf(`Expected error from Peek on empty stack`)
+	}
+	if _, rest, err := stack.Pop(); err == nil || rest.Length() != 0 {
+		t.Fatalf(`Expected error from Pop on empty stack`)
+	}
+}
+
+func Test_Persistent_Stack_keeps_older_versions(t *testing.T) {
+	empty := Persistent_Stack[uint]{}
+	one := empty.Push(1)
+	two := one.Push(2)
+	branch := one.Push(3)
+
+	value, rest, err := two.Pop()
+	if err != nil || value != 2 || rest.Length() != 1 {
+		t.Fatalf(`Expected 2 and stack of one but got %v, %v, %v`, value, rest.Length(), err)
+	}
+	if rest.head != one.head {
+		t.Fatalf(`Expected popped stack to share its tail with older version`)
+	}
+
+	if empty.Length() != 0 || one.Length() != 1 || two.Length() != 2 || branch.Length() != 2 {
+		t.Fatalf(`Expected lengths 0, 1, 2, 2 but got %v, %v, %v, %v`, empty.Length(), one.Length(), two.Length(), branch.Length())
+	}
+	if top, _ := two.Peek(); top != 2 {
+		t.Fatalf(`Expected older version top of 2 but got %v`, top)
+	}
+	if top, _ := branch.Peek(); top != 3 {
+		t.Fatalf(`Expected branch top of 3 but got %v`, top)
+	}
+}
+
+func Test_Persistent_Stack_iterates_top_to_bottom(t *testing.T) {
+	stack := Persistent_Stack[uint]{}
+	for i := uint(1); i <= 4; i++ {
+		stack = stack.Push(i)
+	}
+
+	expected := []uint{4, 3, 2, 1}
+	values := persistentValues(stack)
+	if len(values) != len(expected) {
+		t.Fatalf(`Expected %v but got %v`, expected, values)
+	}
+	for i, value := range expected {
+		if values[i] != value {
+			t.Fatalf(`Expected %v but got %v`, expected, values)
+		}
+	}
+}
+
+func Test_Persistent_Stack_Values_allows_early_breaking(t *testing.T) {
+	stack := Persistent_Stack[uint]{}.Push(1).Push(2).Push(3)
+
+	count := 0
+	for range stack.Values() {
+		count++
+		if count == 2 {
+			break
+		}
+	}
+
+	if count != 2 {
+		t.Fatalf(`Expected 2 iterations but got %v`, count)
+	}
+}
+
+func Test_Persistent_Stack_Reverse(t *testing.T) {
+	stack := Persistent_Stack[uint]{}.Push(1).Push(2).Push(3)
+	reversed := stack.Reverse()
+
+	if reversed.Length() != 3 {
+		t.Fatalf(`Expected length 3 but got %v`, reversed.Length())
+	}
+
+	expected := []uint{1, 2, 3}
+	values := persistentValues(reversed)
+	for i, value := range expected {
+		if values[i] != value {
+			t.Fatalf(`Expected %v but got %v`, expected, values)
+		}
+	}
+
+	if top, _ := stack.Peek(); top != 3 {
+		t.Fatalf(`Expected original top of 3 but got %v`, top)
+	}
+}
+
+func Test_Persistent_Stack_shares_between_goroutines(t *testing.T) {
+	base := Persistent_Stack[uint]{}
+	for i := uint(0); i < 100; i++ {
+		base = base.Push(i)
+	}
+
+	var wait sync.WaitGroup
+	for worker := uint(0); worker < 8; worker++ {
+		wait.Add(1)
+		go func(worker uint) {
+			defer wait.Done()
+
+			stack := base.Push(worker)
+			for stack.Length() > base.Length()-50 {
+				_, stack, _ = stack.Pop()
+			}
+			if top, _ := stack.Peek(); top != 49 {
+				t.Errorf(`Expected top of 49 but got %v`, top)
+			}
+		}(worker)
+	}
+	wait.Wait()
+
+	if base.Length() != 100 {
+		t.Fatalf(`Expected shared base to keep length 100 but got %v`, base.Length())
+	}
+}
@@ -0,0 +1,106 @@
+package stack
+
+import common_errors "common-errors"
+
+// Contiguous stack backed by one slice, pushes only allocate when capacity
+// runs out rather than once per item like `Stack`
+//
+// ## Example
+//
+//	stack := Slice_Stack[rune]{}
+//	stack.Reserve(1024) // following 1024 pushes never allocate
+//	stack.Push('(')
+//	top, err := stack.Pop()
+//
+// @note - `Length` mirrors `len(items)` and must not be written by callers
+type Slice_Stack[T any] struct {
+	Length uint
+	items  []T
+}
+
+/**
+ * Push item onto top of stack
+ */
+func (stack *Slice_Stack[T]) Push(item T) {
+	stack.items = append(stack.items, item)
+	stack.Length++
+}
+
+/**
+ * Remove and return top of stack, or an error
+ */
+func (stack *Slice_Stack[T]) Pop() (T, error) {
+	var result T
+	if stack.Length == 0 {
+		return result, &common_errors.Empty_Error{Container: "Stack"}
+	}
+
+	last := len(stack.items) - 1
+	result = stack.items[last]
+
+	// Clear slot so garbage collector does not keep popped value alive
+	var zero T
+	stack.items[last] = zero
+	stack.items = stack.items[:last]
+	stack.Length--
+
+	return result, nil
+}
+
+/**
+ * Returns top value of stack without mutation
+ */
+func (stack *Slice_Stack[T]) Peek() (T, error) {
+	if stack.Length == 0 {
+		var result T
+		return result, &common_errors.Empty_Error{Container: "Stack"}
+	}
+
+	return stack.items[len(stack.items)-1], nil
+}
+
+/**
+ * Returns number of items, same as reading `Length`, to satisfy `Interface`
+ */
+func (stack *Slice_Stack[T]) Size() uint {
+	return stack.Length
+}
+
+/**
+ * Returns number of items stack can hold before next allocation
+ */
+func (stack *Slice_Stack[T]) Capacity() uint {
+	return uint(cap(stack.items))
+}
+
+/**
+ * Grow capacity so at least `additional` more pushes happen without allocating
+ */
+func (stack *Slice_Stack[T]) Reserve(additional uint) {
+	needed := len(stack.items) + int(additional)
+	if needed <= cap(stack.items) {
+		return
+	}
+
+	items := make([]T, len(stack.items), needed)
+	copy(items, stack.items)
+	stack.items = items
+}
+
+/**
+ * Release unused capacity, useful after a burst of pushes has been popped
+ */
+func (stack *Slice_Stack[T]) Shrink_To_Fit() {
+	if len(stack.items) == cap(stack.items) {
+		return
+	}
+
+	if len(stack.items) == 0 {
+		stack.items = nil
+		return
+	}
+
+	items := make([]T, len(stack.items))
+	copy(items, stack.items)
+	stack.items = items
+}
@@ -0,0 +1,174 @@
+package stack
+
+import (
+	"errors"
+	"testing"
+
+	common_errors "common-errors"
+)
+
+// Every implementation must honour the same last-in first-out contract
+func testInterfaceContract(t *testing.T, stack Interface[uint]) {
+	if _, err := stack.Pop(); !errors.Is(err, common_errors.ErrEmpty) {
+		t.Fatalf(`Expected ErrEmpty popping empty stack but got %v`, err)
+	}
+	if _, err := stack.Peek(); !errors.Is(err, common_errors.ErrEmpty) {
+		t.Fatalf(`Expected ErrEmpty peeking empty stack but got %v`, err)
+	}
+
+	limit := uint(10)
+	for i := uint(0); i < limit; i++ {
+		stack.Push(i)
+	}
+
+	if stack.Size() != limit {
+		t.Fatalf(`Expected stack.Size() of %v but got %v`, limit, stack.Size())
+	}
+
+	for i := limit; i > 0; i-- {
+		peek, err := stack.Peek()
+		if err != nil {
+			t.Fatalf(`Unexpected error %v`, err)
+		}
+
+		value, err := stack.Pop()
+		if err != nil {
+			t.Fatalf(`Unexpected error %v`, err)
+		}
+
+		if value != i-1 || peek != i-1 {
+			t.Fatalf(`Expected stack value of %v but got %v and peeked %v`, i-1, value, peek)
+		}
+
+		if stack.Size() != i-1 {
+			t.Fatalf(`Expected stack size of %v but got %v`, i-1, stack.Size())
+		}
+	}
+}
+
+func Test_Stack_satisfies_Interface(t *testing.T) {
+	testInterfaceContract(t, &Stack[uint]{})
+}
+
+func Test_Slice_Stack_satisfies_Interface(t *testing.T) {
+	testInterfaceContract(t, &Slice_Stack[uint]{})
+}
+
+func Test_Slice_Stack_Reserve_prevents_allocation(t *testing.T) {
+	stack := Slice_Stack[int]{}
+	stack.Reserve(100)
+
+	if stack.Capacity() < 100 {
+		t.Fatalf(`Expected capacity of at least 100 but got %v`, stack.Capacity())
+	}
+
+	allocations := testing.AllocsPerRun(10, func() {
+		for i := 0; i < 100; i++ {
+			stack.Push(i)
+		}
+		for i := 0; i < 100; i++ {
+			stack.Pop()
+		}
+	})
+
+	if allocations != 0 {
+		t.Fatalf(`Expected no allocations after Reserve but got %v`, allocations)
+	}
+}
+
+func Test_Slice_Stack_Reserve_keeps_existing_items(t *testing.T) {
+	stack := Slice_Stack[int]{}
+	stack.Push(1)
+	stack.Push(2)
+	stack.Reserve(1000)
+
+	for _, expected := range []int{2, 1} {
+		value, err := stack.Pop()
+		if err != nil || value != expected {
+			t.Fatalf(`Expected value %v but got %v with error %v`, expected, value, err)
+		}
+	}
+}
+
+func Test_Slice_Stack_Shrink_To_Fit_releases_capacity(t *testing.T) {
+	stack := Slice_Stack[int]{}
+	for i := 0; i < 1000; i++ {
+		stack.Push(i)
+	}
+	for i := 0; i < 990; i++ {
+		stack.Pop()
+	}
+
+	stack.Shrink_To_Fit()
+
+	if stack.Capacity() != 10 {
+		t.Fatalf(`Expected capacity of 10 but got %v`, stack.Capacity())
+	}
+
+	peek, _ := stack.Peek()
+	if peek != 9 {
+		t.Fatalf(`Expected top value 9 but got %v`, peek)
+	}
+}
+
+func Test_Slice_Stack_Pop_clears_slot_for_garbage_collector(t *testing.T) {
+	stack := Slice_Stack[*int]{}
+
+	value := 42
+	stack.Push(&value)
+	stack.Pop()
+
+	// Slot beyond length is still reachable through the backing array
+	if slot := stack.items[:1][0]; slot != nil {
+		t.Fatalf(`Expected popped slot to be cleared but it still holds %v`, slot)
+	}
+}
+
+func Benchmark_Stack_push_pop(b *testing.B) {
+	b.ReportAllocs()
+	stack := Stack[int]{}
+	for i := 0; i < b.N; i++ {
+		stack.Push(i)
+		stack.Push(i)
+		stack.Pop()
+		stack.Pop()
+	}
+}
+
+func Benchmark_Slice_Stack_push_pop(b *testing.B) {
+	b.ReportAllocs()
+	stack := Slice_Stack[int]{}
+	for i := 0; i < b.N; i++ {
+		stack.Push(i)
+		stack.Push(i)
+		stack.Pop()
+		stack.Pop()
+	}
+}
+
+func Benchmark_Stack_deep_push_then_pop(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		stack := Stack[int]{}
+		for j := 0; j < 1024; j++ {
+			stack.Push(j)
+		}
+		for j := 0; j < 1024; j++ {
+			stack.Pop()
+		}
+	}
+}
+
+func Benchmark_Slice_Stack_deep_push_then_pop(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		stack := Slice_Stack[int]{}
+		stack.Reserve(1024)
+		for j := 0; j < 1024; j++ {
+			stack.Push(j)
+		}
+		for j := 0; j < 1024; j++ {
+			stack.Pop()
+		}
+	}
+}
@@ -1,6 +1,6 @@
 package stack
 
-import "errors"
+import common_errors "common-errors"
 
 type Node[T any] struct {
 	value T
@@ -12,6 +12,14 @@ type Stack[T any] struct {
 	head   *Node[T]
 }
 
+// Behaviour shared by every stack implementation so callers can swap them
+type Interface[T any] interface {
+	Push(item T)
+	Pop() (T, error)
+	Peek() (T, error)
+	Size() uint
+}
+
 /**
  *
  */
@@ -37,7 +45,7 @@ func (stack *Stack[T]) Push(item T) {
 func (stack *Stack[T]) Pop() (T, error) {
 	if stack.Length == 0 {
 		var result T
-		return result, errors.New("Stack is empty")
+		return result, &common_errors.Empty_Error{Container: "Stack"}
 	}
 
 	stack.Length--
@@ -53,8 +61,15 @@ func (stack *Stack[T]) Pop() (T, error) {
 func (stack *Stack[T]) Peek() (T, error) {
 	if stack.Length == 0 {
 		var result T
-		return result, errors.New("Queue is empty")
+		return result, &common_errors.Empty_Error{Container: "Stack"}
 	}
 
 	return stack.head.value, nil
 }
+
+/**
+ * Returns number of items, same as reading `Length`, to satisfy `Interface`
+ */
+func (stack *Stack[T]) Size() uint {
+	return stack.Length
+}
@@ -0,0 +1,172 @@
+package stack
+
+import (
+	common_errors "common-errors"
+	"math/rand"
+	"sync/atomic"
+)
+
+// Attempts an eliminating push waits for a partner before withdrawing
+const elimination_spins = 64
+
+type treiber_node[T any] struct {
+	value T
+	prev  *treiber_node[T]
+}
+
+// Slots where a colliding push and pop may swap a node directly and skip the
+// contended `head` entirely
+type elimination_array[T any] struct {
+	slots []atomic.Pointer[treiber_node[T]]
+
+	// Completed exchanges, a push and pop that met here count once
+	exchanges atomic.Int64
+
+	// Called once a node is offered, nil outside tests which use it to force
+	// a collision
+	offered func()
+}
+
+// Offer node in random slot, returns true when a popper took it
+func (array *elimination_array[T]) tryPush(node *treiber_node[T]) bool {
+	slot := &array.slots[rand.Intn(len(array.slots))]
+	if !slot.CompareAndSwap(nil, node) {
+		return false
+	}
+	if array.offered != nil {
+		array.offered()
+	}
+
+	for i := 0; i < elimination_spins; i++ {
+		if slot.Load() != node {
+			return true
+		}
+	}
+
+	// Withdraw, failing means a popper swapped the node out just in time
+	return !slot.CompareAndSwap(node, nil)
+}
+
+// Take node offered by a concurrent push, if any
+func (array *elimination_array[T]) tryPop() (*treiber_node[T], bool) {
+	slot := &array.slots[rand.Intn(len(array.slots))]
+	node := slot.Load()
+	if node == nil || !slot.CompareAndSwap(node, nil) {
+		return nil, false
+	}
+	array.exchanges.Add(1)
+	return node, true
+}
+
+// Treiber lock-free stack, `head` is swapped with compare-and-swap so any
+// number of goroutines may push and pop without a mutex
+//
+// ## Example
+//
+//	stack := New_Treiber_Stack[int](8) // 8 elimination slots
+//	go stack.Push(1)
+//	value, err := stack.Pop()
+//
+// @notes
+//
+// - Zero value is ready to use, without elimination backoff
+// - Garbage collection rules out ABA, a node address is never reused while
+// any goroutine still holds it
+// - Must not be copied after first use
+type Treiber_Stack[T any] struct {
+	head        atomic.Pointer[treiber_node[T]]
+	length      atomic.Int64
+	elimination *elimination_array[T]
+}
+
+// Returns stack where a push and pop that lose a race on `head` try to cancel
+// each other out in one of `width` slots, width of zero disables this
+func New_Treiber_Stack[T any](width int) *Treiber_Stack[T] {
+	stack := &Treiber_Stack[T]{}
+	if width > 0 {
+		stack.elimination = &elimination_array[T]{
+			slots: make([]atomic.Pointer[treiber_node[T]], width),
+		}
+	}
+	return stack
+}
+
+/**
+ * Push item onto top of stack
+ */
+func (stack *Treiber_Stack[T]) Push(item T) {
+	node := &treiber_node[T]{
+		value: item,
+	}
+
+	for {
+		head := stack.head.Load()
+		node.prev = head
+		if stack.head.CompareAndSwap(head, node) {
+			stack.length.Add(1)
+			return
+		}
+
+		if stack.elimination != nil && stack.elimination.tryPush(node) {
+			return
+		}
+	}
+}
+
+/**
+ * Remove and return top of stack, or an error
+ */
+func (stack *Treiber_Stack[T]) Pop() (T, error) {
+	for {
+		head := stack.head.Load()
+		if head == nil {
+			var result T
+			return result, &common_errors.Empty_Error{Container: "Stack"}
+		}
+
+		if stack.head.CompareAndSwap(head, head.prev) {
+			stack.length.Add(-1)
+			return head.value, nil
+		}
+
+		if stack.elimination != nil {
+			if node, ok := stack.elimination.tryPop(); ok {
+				return node.value, nil
+			}
+		}
+	}
+}
+
+/**
+ * Returns top value of stack without mutation
+ */
+func (stack *Treiber_Stack[T]) Peek() (T, error) {
+	head := stack.head.Load()
+	if head == nil {
+		var result T
+		return result, &common_errors.Empty_Error{Container: "Stack"}
+	}
+
+	return head.value, nil
+}
+
+/**
+ * Returns snapshot of number of items in stack
+ *
+ * @note - under concurrent use the count may already be stale when returned
+ */
+func (stack *Treiber_Stack[T]) Length() uint {
+	length := stack.length.Load()
+	if length < 0 {
+		// Pop may decrement before a racing Push increments
+		return 0
+	}
+	return uint(length)
+}
+
+/**
+ * Returns number of items, same as `Length`, to satisfy `Interface`
+ */
+func (stack *Treiber_Stack[T]) Size() uint {
+	return stack.Length()
+}
@@ -0,0 +1,162 @@
+package stack
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func Test_Treiber_Stack_satisfies_Interface(t *testing.T) {
+	testInterfaceContract(t, &Treiber_Stack[uint]{})
+	testInterfaceContract(t, New_Treiber_Stack[uint](4))
+}
+
+// Every pushed value must be popped exactly once, run with `go test -race`
+func testTreiberStackStress(t *testing.T, stack *Treiber_Stack[int]) {
+	workers := 8
+	per_worker := 2000
+	total := workers * per_worker
+
+	seen := make([]int, total)
+	var seen_mutex sync.Mutex
+	record := func(value int) {
+		seen_mutex.Lock()
+		seen[value]++
+		seen_mutex.Unlock()
+	}
+
+	// Every worker alternates pushes and pops so both sides stay contended
+	var group sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		group.Add(1)
+		go func(offset int) {
+			defer group.Done()
+			for i := 0; i < per_worker; i++ {
+				stack.Push(offset + i)
+				if i%2 == 1 {
+					for {
+						value, err := stack.Pop()
+						if err == nil {
+							record(value)
+							break
+						}
+					}
+				}
+			}
+		}(w * per_worker)
+	}
+	group.Wait()
+
+	for {
+		value, err := stack.Pop()
+		if err != nil {
+			break
+		}
+		record(value)
+	}
+
+	for value, count := range seen {
+		if count != 1 {
+			t.Fatalf(`Expected value %v popped once but was popped %v times`, value, count)
+		}
+	}
+
+	if stack.Length() != 0 {
+		t.Fatalf(`Expected empty stack but got length %v`, stack.Length())
+	}
+}
+
+func Test_Treiber_Stack_pops_every_value_exactly_once(t *testing.T) {
+	testTreiberStackStress(t, &Treiber_Stack[int]{})
+}
+
+func Test_Treiber_Stack_with_elimination_pops_every_value_exactly_once(t *testing.T) {
+	stack := New_Treiber_Stack[int](4)
+	testTreiberStackStress(t, stack)
+	t.Logf(`%v pushes and pops met in elimination array`, stack.elimination.exchanges.Load())
+}
+
+// Popping from inside the offer hook forces the collision that contention
+// only produces by chance
+func Test_elimination_array_exchanges_node_between_push_and_pop(t *testing.T) {
+	array := &elimination_array[int]{
+		slots: make([]atomic.Pointer[treiber_node[int]], 1),
+	}
+
+	var popped *treiber_node[int]
+	array.offered = func() {
+		node, ok := array.tryPop()
+		if !ok {
+			t.Fatalf(`Expected pop to take offered node`)
+		}
+		popped = node
+	}
+
+	pushed := &treiber_node[int]{value: 42}
+	if !array.tryPush(pushed) {
+		t.Fatalf(`Expected push to be taken by pop`)
+	}
+	if popped != pushed || popped.value != 42 {
+		t.Fatalf(`Expected pop to receive pushed node but got %v`, popped)
+	}
+	if exchanges := array.exchanges.Load(); exchanges != 1 {
+		t.Fatalf(`Expected 1 exchange but got %v`, exchanges)
+	}
+	if array.slots[0].Load() != nil {
+		t.Fatalf(`Expected slot empty after exchange`)
+	}
+}
+
+func Test_elimination_array_withdraws_unclaimed_offer(t *testing.T) {
+	array := &elimination_array[int]{
+		slots: make([]atomic.Pointer[treiber_node[int]], 1),
+	}
+
+	if array.tryPush(&treiber_node[int]{value: 1}) {
+		t.Fatalf(`Expected unclaimed push to withdraw`)
+	}
+	if _, ok := array.tryPop(); ok || array.exchanges.Load() != 0 {
+		t.Fatalf(`Expected nothing left to pop after withdrawal`)
+	}
+}
+
+type mutex_stack[T any] struct {
+	mutex sync.Mutex
+	stack Stack[T]
+}
+
+func (wrapped *mutex_stack[T]) Push(item T) {
+	wrapped.mutex.Lock()
+	wrapped.stack.Push(item)
+	wrapped.mutex.Unlock()
+}
+
+func (wrapped *mutex_stack[T]) Pop() (T, error) {
+	wrapped.mutex.Lock()
+	defer wrapped.mutex.Unlock()
+	return wrapped.stack.Pop()
+}
+
+func benchmarkParallelPushPop(b *testing.B, push func(int), pop func() (int, error)) {
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			push(i)
+			pop()
+		}
+	})
+}
+
+func Benchmark_Treiber_Stack_parallel_push_pop(b *testing.B) {
+	stack := Treiber_Stack[int]{}
+	benchmarkParallelPushPop(b, stack.Push, stack.Pop)
+}
+
+func Benchmark_Treiber_Stack_with_elimination_parallel_push_pop(b *testing.B) {
+	stack := New_Treiber_Stack[int](8)
+	benchmarkParallelPushPop(b, stack.Push, stack.Pop)
+}
+
+func Benchmark_Mutex_Stack_parallel_push_pop(b *testing.B) {
+	stack := mutex_stack[int]{}
+	benchmarkParallelPushPop(b, stack.Push, stack.Pop)
+}
@@ -1,3 +1,7 @@
 module two-crystal-balls
 
 go 1.21.2
+
+require common-errors v0.0.0
+
+replace common-errors => ../common-errors
@@ -1,7 +1,7 @@
 package two_crystal_balls
 
 import (
-	"errors"
+	common_errors "common-errors"
 	"math"
 )
 
@@ -25,5 +25,5 @@ func Two_Crystal_Balls[T comparable](breaks []T, target T) (int, error) {
 		i++
 	}
 
-	return int(-1), errors.New("Target does not exist in list")
+	return int(-1), &common_errors.Not_Found_Error{Target: "Target", Container: "list"}
 }
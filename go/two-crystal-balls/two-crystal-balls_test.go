@@ -1,6 +1,8 @@
 package two_crystal_balls
 
 import (
+	common_errors "common-errors"
+	"errors"
 	"math/rand"
 	"testing"
 	"time"
@@ -34,8 +36,8 @@ func Test_Two_Crystal_Balls_returns_error_when_target_not_found(t *testing.T) {
 	expected_index := -1
 
 	found_index, err := Two_Crystal_Balls(haystack, target)
-	if err == nil {
-		t.Fatalf(`Expected error not nil -> %v`, err)
+	if !errors.Is(err, common_errors.ErrNotFound) {
+		t.Fatalf(`Expected ErrNotFound but got -> %v`, err)
 	}
 
 	if found_index != expected_index {
@@ -0,0 +1,120 @@
+package unrolled_linked_list
+
+import (
+	doubly_linked_list "doubly-linked-list"
+	"fmt"
+	"iter"
+	"math/rand/v2"
+	"testing"
+)
+
+// Operations both lists share, so each workload runs the same code against
+// either
+type sequence struct {
+	append   func(int)
+	prepend  func(int)
+	get      func(uint) (int, error)
+	insertAt func(int, uint) (any, error)
+	removeAt func(uint) (int, error)
+	values   func() iter.Seq[int]
+}
+
+var implementations = []struct {
+	name string
+	make func() sequence
+}{
+	{"Unrolled", func() sequence {
+		list := &Unrolled_Linked_List[int]{}
+		return sequence{
+			append:   list.Append,
+			prepend:  list.Prepend,
+			get:      list.Get,
+			insertAt: list.InsertAt,
+			removeAt: list.RemoveAt,
+			values:   list.Values,
+		}
+	}},
+	{"Doubly_Linked", func() sequence {
+		list := &doubly_linked_list.Doubly_Linked_List[int]{}
+		return sequence{
+			append:   func(item int) { list.Append(item) },
+			prepend:  func(item int) { list.Prepend(item) },
+			get:      list.Get,
+			insertAt: list.InsertAt,
+			removeAt: list.RemoveAt,
+			values:   list.Values,
+		}
+	}},
+}
+
+var sizes = []int{1_000, 100_000, 1_000_000}
+
+// Run workload against every implementation and size, with a list already
+// holding `size` items
+func benchmarkWorkload(b *testing.B, workload func(b *testing.B, list sequence, size int)) {
+	for _, size := range sizes {
+		for _, implementation := range implementations {
+			b.Run(fmt.Sprintf("%s/n=%d", implementation.name, size), func(b *testing.B) {
+				list := implementation.make()
+				for i := 0; i < size; i++ {
+					list.append(i)
+				}
+
+				b.ResetTimer()
+				workload(b, list, size)
+			})
+		}
+	}
+}
+
+func Benchmark_Append(b *testing.B) {
+	for _, implementation := range implementations {
+		b.Run(implementation.name, func(b *testing.B) {
+			list := implementation.make()
+			for i := 0; i < b.N; i++ {
+				list.append(i)
+			}
+		})
+	}
+}
+
+func Benchmark_Prepend(b *testing.B) {
+	for _, implementation := range implementations {
+		b.Run(implementation.name, func(b *testing.B) {
+			list := implementation.make()
+			for i := 0; i < b.N; i++ {
+				list.prepend(i)
+			}
+		})
+	}
+}
+
+func Benchmark_Get_random(b *testing.B) {
+	benchmarkWorkload(b, func(b *testing.B, list sequence, size int) {
+		rng := rand.New(rand.NewPCG(1, 2))
+		for i := 0; i < b.N; i++ {
+			list.get(uint(rng.IntN(size)))
+		}
+	})
+}
+
+func Benchmark_InsertAt_and_RemoveAt_random(b *testing.B) {
+	benchmarkWorkload(b, func(b *testing.B, list sequence, size int) {
+		rng := rand.New(rand.NewPCG(1, 2))
+		for i := 0; i < b.N; i++ {
+			list.insertAt(i, uint(rng.IntN(size)))
+			list.removeAt(uint(rng.IntN(size)))
+		}
+	})
+}
+
+func Benchmark_Iterate(b *testing.B) {
+	benchmarkWorkload(b, func(b *testing.B, list sequence, size int) {
+		for i := 0; i < b.N; i++ {
+			sum := 0
+			for value := range list.values() {
+				sum += value
+			}
+		}
+	})
+}
@@ -0,0 +1,80 @@
+package unrolled_linked_list
+
+import "iter"
+
+// Iterate from head, to tail, yielding index/value pairs
+//
+// ## Example
+//
+//	for index, value := range list.All() {
+//		fmt.Println("index ->", index, "value ->", value)
+//	}
+//
+// @note - any mutation during iteration has undefined results
+func (list *List[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		index := 0
+		for curr := list.head; curr != nil; curr = curr.next {
+			for i := 0; i < curr.count; i++ {
+				if !yield(index, curr.items[i]) {
+					return
+				}
+				index++
+			}
+		}
+	}
+}
+
+// Iterate from tail, to head, yielding index/value pairs, index of tail is
+// `Length - 1` as it would be for `Get`
+//
+// @note - same mutation rules as `All`
+func (list *List[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		index := int(list.Length) - 1
+		for curr := list.tail; curr != nil; curr = curr.prev {
+			for i := curr.count - 1; i >= 0; i-- {
+				if !yield(index, curr.items[i]) {
+					return
+				}
+				index--
+			}
+		}
+	}
+}
+
+// Iterate values from head, to tail
+//
+// ## Example
+//
+//	values := slices.Collect(list.Values())
+//
+// @note - same mutation rules as `All`
+func (list *List[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, value := range list.All() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Remove and yield values from head until list is empty, stopping early
+// leaves the rest
+func (list *List[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for list.Length > 0 {
+			if !yield(list.removeFrom(list.head, 0)) {
+				return
+			}
+		}
+	}
+}
+
+// Append every value of sequence in order
+func (list *List[T]) Append_All(seq iter.Seq[T]) {
+	for value := range seq {
+		list.Append(value)
+	}
+}
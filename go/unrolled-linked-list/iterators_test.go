@@ -0,0 +1,54 @@
+package unrolled_linked_list
+
+import (
+	"slices"
+	"testing"
+)
+
+func Test_All_and_Backward_agree_on_indexes(t *testing.T) {
+	list := List[int]{}
+	limit := Node_Capacity*2 + 3
+	for i := 0; i < limit; i++ {
+		list.Append(i * 10)
+	}
+
+	count := 0
+	for index, value := range list.All() {
+		if value != index*10 {
+			t.Fatalf(`Expected %v at index %v but got %v`, index*10, index, value)
+		}
+		count++
+	}
+
+	backward := 0
+	for index, value := range list.Backward() {
+		if value != index*10 || index != limit-1-backward {
+			t.Fatalf(`Unexpected value %v at index %v`, value, index)
+		}
+		backward++
+	}
+
+	if count != limit || backward != limit {
+		t.Fatalf(`Expected %v items each way but got %v and %v`, limit, count, backward)
+	}
+}
+
+func Test_Drain_empties_list_in_order(t *testing.T) {
+	list := List[int]{}
+	list.Append_All(slices.Values([]int{1, 2, 3, 4}))
+
+	drained := []int{}
+	for value := range list.Drain() {
+		drained = append(drained, value)
+		if value == 2 {
+			break
+		}
+	}
+	checkInvariants(t, &list, []int{3, 4})
+
+	drained = append(drained, slices.Collect(list.Drain())...)
+	if !slices.Equal(drained, []int{1, 2, 3, 4}) || list.Length != 0 {
+		t.Fatalf(`Expected to drain [1 2 3 4] but got %v`, drained)
+	}
+	checkInvariants(t, &list, []int{})
+}
@@ -0,0 +1,77 @@
+package unrolled_linked_list
+
+import common_errors "common-errors"
+
+// Traverse list, from head to tail, and return index of first item matching
+// predicate, or -1
+func (list *List[T]) Index_Func(predicate func(T) bool) int {
+	index := 0
+	for curr := list.head; curr != nil; curr = curr.next {
+		for i := 0; i < curr.count; i++ {
+			if predicate(curr.items[i]) {
+				return index
+			}
+			index++
+		}
+	}
+	return -1
+}
+
+// Traverse list, from head to tail, and return first item matching predicate
+func (list *List[T]) Find_Func(predicate func(T) bool) (T, error) {
+	for curr := list.head; curr != nil; curr = curr.next {
+		for i := 0; i < curr.count; i++ {
+			if predicate(curr.items[i]) {
+				return curr.items[i], nil
+			}
+		}
+	}
+
+	var result T
+	return result, &common_errors.Not_Found_Error{Target: "Value", Container: "list"}
+}
+
+// Report whether any item matches predicate
+func (list *List[T]) Contains_Func(predicate func(T) bool) bool {
+	return list.Index_Func(predicate) >= 0
+}
+
+// Traverse list, from head to tail, and remove first item matching predicate
+func (list *List[T]) Remove_Func(predicate func(T) bool) (T, error) {
+	if list.Length == 0 {
+		var result T
+		return result, &common_errors.Empty_Error{Container: "List"}
+	}
+
+	for curr := list.head; curr != nil; curr = curr.next {
+		for i := 0; i < curr.count; i++ {
+			if predicate(curr.items[i]) {
+				return list.removeFrom(curr, i), nil
+			}
+		}
+	}
+
+	var result T
+	return result, &common_errors.Not_Found_Error{Target: "Value", Container: "list"}
+}
+
+// Traverse list, from head to tail, and remove first item with matching value
+func (list *Unrolled_Linked_List[T]) Remove(item T) (T, error) {
+	return list.Remove_Func(equalTo(item))
+}
+
+// Returns index of first item equal to `item`, or -1
+func (list *Unrolled_Linked_List[T]) Index_Of(item T) int {
+	return list.Index_Func(equalTo(item))
+}
+
+// Report whether list holds an item equal to `item`
+func (list *Unrolled_Linked_List[T]) Contains(item T) bool {
+	return list.Contains_Func(equalTo(item))
+}
+
+func equalTo[T comparable](item T) func(T) bool {
+	return func(value T) bool {
+		return value == item
+	}
+}
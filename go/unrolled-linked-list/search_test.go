@@ -0,0 +1,59 @@
+package unrolled_linked_list
+
+import (
+	"errors"
+	"testing"
+
+	common_errors "common-errors"
+)
+
+func Test_Remove_Index_Of_and_Contains(t *testing.T) {
+	list := Unrolled_Linked_List[int]{}
+	expected := []int{}
+	for i := 0; i < Node_Capacity*2; i++ {
+		list.Append(i)
+		if i != 70 {
+			expected = append(expected, i)
+		}
+	}
+
+	if index := list.Index_Of(70); index != 70 {
+		t.Fatalf(`Expected Index_Of(70) of 70 but got %v`, index)
+	}
+	if value, err := list.Remove(70); err != nil || value != 70 {
+		t.Fatalf(`Expected to remove 70 but got %v, %v`, value, err)
+	}
+	if list.Contains(70) || list.Index_Of(70) != -1 {
+		t.Fatalf(`Expected 70 to be gone`)
+	}
+	checkInvariants(t, &list.List, expected)
+
+	if _, err := list.Remove(70); !errors.Is(err, common_errors.ErrNotFound) {
+		t.Fatalf(`Expected ErrNotFound but got %v`, err)
+	}
+}
+
+func Test_Func_variants_hold_non_comparable_items(t *testing.T) {
+	list := List[[]int]{}
+	list.Append([]int{1})
+	list.Append([]int{2, 2})
+
+	hasLength := func(length int) func([]int) bool {
+		return func(items []int) bool { return len(items) == length }
+	}
+
+	if found, err := list.Find_Func(hasLength(2)); err != nil || found[0] != 2 {
+		t.Fatalf(`Expected [2 2] but got %v, %v`, found, err)
+	}
+	if _, err := list.Remove_Func(hasLength(1)); err != nil || list.Length != 1 {
+		t.Fatalf(`Expected to remove [1] but got %v`, err)
+	}
+	if _, err := list.Find_Func(hasLength(9)); !errors.Is(err, common_errors.ErrNotFound) {
+		t.Fatalf(`Expected ErrNotFound but got %v`, err)
+	}
+
+	empty := List[[]int]{}
+	if _, err := empty.Remove_Func(hasLength(1)); !errors.Is(err, common_errors.ErrEmpty) {
+		t.Fatalf(`Expected ErrEmpty but got %v`, err)
+	}
+}
@@ -0,0 +1,252 @@
+package unrolled_linked_list
+
+import (
+	common_errors "common-errors"
+)
+
+// Items held by each node, a full node splits in two and a node that falls
+// below half full borrows from or merges with its neighbour
+const Node_Capacity = 64
+
+const min_fill = Node_Capacity / 2
+
+// Holds a run of up to `Node_Capacity` items and pointers to next/previous
+// nodes
+type node[T any] struct {
+	items [Node_Capacity]T
+	count int
+	next  *node[T]
+	prev  *node[T]
+}
+
+// Holds length and pointers to head/tail nodes, same API as
+// `doubly_linked_list.List` but lookups skip whole nodes and neighbouring
+// items share cache lines
+//
+// ## Example
+//
+//	list := List[int]{}
+//	for i := 0; i < 1_000_000; i++ {
+//		list.Append(i)
+//	}
+//	value, _ := list.Get(500_000) // visits about 7800 nodes, not 500000
+//
+// @notes
+//
+// - Items move between nodes as nodes split and merge, so there are no node
+// handles and `Append`/`Prepend` return nothing
+// - Removing the current item while iterating has undefined results, unlike
+// `doubly_linked_list.List`, use `Remove_Func` or `Drain` instead
+type List[T any] struct {
+	Length uint
+	head   *node[T]
+	tail   *node[T]
+}
+
+// List of comparable items, adds `==` based conveniences such as `Remove`
+// and `Contains` on top of everything `List` offers
+type Unrolled_Linked_List[T comparable] struct {
+	List[T]
+}
+
+// Insert item at head of list
+func (list *List[T]) Prepend(item T) {
+	if list.head == nil || list.head.count == Node_Capacity {
+		list.linkAfter(nil, &node[T]{})
+	}
+	list.head.insert(0, item)
+	list.Length++
+}
+
+// Insert item before position `index`, an index equal to `Length` appends,
+// errors with `*common_errors.Index_Error` past that
+func (list *List[T]) InsertAt(item T, index uint) (any, error) {
+	if index > list.Length {
+		return nil, &common_errors.Index_Error{Index: index, Length: list.Length}
+	} else if index == list.Length {
+		list.Append(item)
+		return nil, nil
+	}
+
+	curr, offset := list.locate(index)
+	if curr.count == Node_Capacity {
+		next := list.split(curr)
+		if offset >= curr.count {
+			offset -= curr.count
+			curr = next
+		}
+	}
+
+	curr.insert(offset, item)
+	list.Length++
+	return nil, nil
+}
+
+// Insert item at tail of list
+func (list *List[T]) Append(item T) {
+	if list.tail == nil || list.tail.count == Node_Capacity {
+		list.linkAfter(list.tail, &node[T]{})
+	}
+	list.tail.insert(list.tail.count, item)
+	list.Length++
+}
+
+// Traverse nodes and attempt to retrieve value at given index
+func (list *List[T]) Get(index uint) (T, error) {
+	if err := list.checkIndex(index); err != nil {
+		var result T
+		return result, err
+	}
+
+	curr, offset := list.locate(index)
+	return curr.items[offset], nil
+}
+
+// Traverse nodes and attempt to remove value at given index
+func (list *List[T]) RemoveAt(index uint) (T, error) {
+	if err := list.checkIndex(index); err != nil {
+		var result T
+		return result, err
+	}
+
+	curr, offset := list.locate(index)
+	return list.removeFrom(curr, offset), nil
+}
+
+// Shift items after offset up one slot and store item at offset
+// @note - Callers must make sure node has room
+func (curr *node[T]) insert(offset int, item T) {
+	copy(curr.items[offset+1:curr.count+1], curr.items[offset:curr.count])
+	curr.items[offset] = item
+	curr.count++
+}
+
+// Return item at offset after closing the gap and rebalancing node with its
+// neighbours
+func (list *List[T]) removeFrom(curr *node[T], offset int) T {
+	value := curr.items[offset]
+	copy(curr.items[offset:curr.count-1], curr.items[offset+1:curr.count])
+	curr.count--
+
+	// Free memory
+	var zero T
+	curr.items[curr.count] = zero
+
+	list.Length--
+	list.rebalance(curr)
+	return value
+}
+
+// Move upper half of full node into a new node linked after it, returns the
+// new node
+func (list *List[T]) split(curr *node[T]) *node[T] {
+	next := &node[T]{}
+	next.count = copy(next.items[:], curr.items[min_fill:curr.count])
+	clear(curr.items[min_fill:curr.count])
+	curr.count = min_fill
+
+	list.linkAfter(curr, next)
+	return next
+}
+
+// Top up node that fell below half full, merging with next node when both
+// fit in one, else borrowing from it, the tail merges backwards instead
+func (list *List[T]) rebalance(curr *node[T]) {
+	if curr.count == 0 {
+		list.unlink(curr)
+		return
+	} else if curr.count >= min_fill {
+		return
+	}
+
+	if next := curr.next; next != nil {
+		if curr.count+next.count <= Node_Capacity {
+			curr.count += copy(curr.items[curr.count:], next.items[:next.count])
+			list.unlink(next)
+			return
+		}
+
+		// Next holds more than `Node_Capacity - curr.count` items, so it
+		// stays at least half full after lending these
+		moved := min_fill - curr.count
+		copy(curr.items[curr.count:], next.items[:moved])
+		curr.count += moved
+		copy(next.items[:], next.items[moved:next.count])
+		clear(next.items[next.count-moved : next.count])
+		next.count -= moved
+		return
+	}
+
+	if prev := curr.prev; prev != nil && prev.count+curr.count <= Node_Capacity {
+		prev.count += copy(prev.items[prev.count:], curr.items[:curr.count])
+		list.unlink(curr)
+	}
+}
+
+// Attach node after `at`, or at head when `at` is nil
+func (list *List[T]) linkAfter(at *node[T], curr *node[T]) {
+	curr.prev = at
+	if at == nil {
+		curr.next = list.head
+		list.head = curr
+	} else {
+		curr.next = at.next
+		at.next = curr
+	}
+
+	if curr.next != nil {
+		curr.next.prev = curr
+	} else {
+		list.tail = curr
+	}
+}
+
+// Detach node, updating connections and list pointers
+func (list *List[T]) unlink(curr *node[T]) {
+	if curr.prev != nil {
+		curr.prev.next = curr.next
+	} else {
+		list.head = curr.next
+	}
+	if curr.next != nil {
+		curr.next.prev = curr.prev
+	} else {
+		list.tail = curr.prev
+	}
+
+	// Free memory
+	curr.next = nil
+	curr.prev = nil
+}
+
+func (list *List[T]) checkIndex(index uint) error {
+	if list.Length == 0 {
+		return &common_errors.Empty_Error{Container: "List"}
+	} else if index >= list.Length {
+		return &common_errors.Index_Error{Index: index, Length: list.Length}
+	}
+	return nil
+}
+
+// Traverse nodes from end closest to target index, returning node holding
+// it and offset within that node
+// @note - Callers must perform bounds checks
+func (list *List[T]) locate(index uint) (*node[T], int) {
+	if index < list.Length/2 {
+		curr := list.head
+		for index >= uint(curr.count) {
+			index -= uint(curr.count)
+			curr = curr.next
+		}
+		return curr, int(index)
+	}
+
+	// Count back from tail, 1 is last item
+	remaining := list.Length - index
+	curr := list.tail
+	for remaining > uint(curr.count) {
+		remaining -= uint(curr.count)
+		curr = curr.prev
+	}
+	return curr, curr.count - int(remaining)
+}
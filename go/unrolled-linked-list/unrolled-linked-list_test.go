@@ -0,0 +1,163 @@
+package unrolled_linked_list
+
+import (
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	common_errors "common-errors"
+)
+
+// Check links, ends, node counts and `Length` against expected
+func checkInvariants[T comparable](t *testing.T, list *List[T], expected []T) {
+	t.Helper()
+
+	if list.Length != uint(len(expected)) {
+		t.Fatalf(`Expected Length %v but got %v`, len(expected), list.Length)
+	}
+
+	index := 0
+	var prev *node[T]
+	for curr := list.head; curr != nil; curr = curr.next {
+		if curr.prev != prev {
+			t.Fatalf(`Broken prev link at index %v`, index)
+		}
+		if curr.count == 0 || curr.count > Node_Capacity {
+			t.Fatalf(`Node at index %v holds %v items`, index, curr.count)
+		}
+		for i := 0; i < curr.count; i++ {
+			if index >= len(expected) || curr.items[i] != expected[index] {
+				t.Fatalf(`Unexpected value %v at index %v`, curr.items[i], index)
+			}
+			index++
+		}
+		prev = curr
+	}
+
+	if index != len(expected) || list.tail != prev {
+		t.Fatalf(`Expected %v items ending at tail but walked %v`, len(expected), index)
+	}
+}
+
+func Test_Append_and_Prepend_fill_nodes(t *testing.T) {
+	list := Unrolled_Linked_List[int]{}
+	expected := []int{}
+
+	limit := Node_Capacity*3 + 5
+	for i := 0; i < limit; i++ {
+		list.Append(i)
+		list.Prepend(-i - 1)
+		expected = append([]int{-i - 1}, append(expected, i)...)
+	}
+
+	checkInvariants(t, &list.List, expected)
+}
+
+func Test_List_matches_slice_model(t *testing.T) {
+	list := List[int]{}
+	model := []int{}
+	rng := rand.New(rand.NewPCG(1, 2))
+
+	for step := 0; step < 20000; step++ {
+		switch operation := rng.IntN(10); {
+		case operation < 4:
+			index := rng.IntN(len(model) + 1)
+			if _, err := list.InsertAt(step, uint(index)); err != nil {
+				t.Fatalf(`Unexpected error %v`, err)
+			}
+			model = slices.Insert(model, index, step)
+		case operation < 5:
+			list.Append(step)
+			model = append(model, step)
+		case operation < 6:
+			list.Prepend(step)
+			model = slices.Insert(model, 0, step)
+		default:
+			if len(model) == 0 {
+				continue
+			}
+			index := rng.IntN(len(model))
+			value, err := list.RemoveAt(uint(index))
+			if err != nil || value != model[index] {
+				t.Fatalf(`Expected to remove %v but got %v, %v`, model[index], value, err)
+			}
+			model = slices.Delete(model, index, index+1)
+		}
+
+		if step%500 == 0 {
+			checkInvariants(t, &list, model)
+		}
+	}
+	checkInvariants(t, &list, model)
+
+	for index, expected := range model {
+		if value, err := list.Get(uint(index)); err != nil || value != expected {
+			t.Fatalf(`Expected Get(%v) of %v but got %v, %v`, index, expected, value, err)
+		}
+	}
+}
+
+func Test_removals_merge_sparse_nodes(t *testing.T) {
+	list := List[int]{}
+	limit := Node_Capacity * 8
+	for i := 0; i < limit; i++ {
+		list.Append(i)
+	}
+
+	// Remove every other item, nodes must merge rather than sit half empty
+	expected := []int{}
+	for i := 0; i < limit; i++ {
+		if i%2 == 1 {
+			expected = append(expected, i)
+		}
+	}
+	for i := 0; i < limit/2; i++ {
+		list.RemoveAt(uint(i))
+	}
+	checkInvariants(t, &list, expected)
+
+	nodes := 0
+	for curr := list.head; curr != nil; curr = curr.next {
+		if curr != list.tail && curr.count < min_fill {
+			t.Fatalf(`Expected nodes other than tail at least half full but found %v items`, curr.count)
+		}
+		nodes++
+	}
+	if max_nodes := len(expected)/min_fill + 1; nodes > max_nodes {
+		t.Fatalf(`Expected at most %v nodes but got %v`, max_nodes, nodes)
+	}
+}
+
+func Test_InsertAt_errors_past_end(t *testing.T) {
+	list := List[int]{}
+	list.Append(1)
+
+	_, err := list.InsertAt(2, 2)
+	var index_err *common_errors.Index_Error
+	if !errors.As(err, &index_err) || index_err.Index != 2 || index_err.Length != 1 {
+		t.Fatalf(`Expected *Index_Error for index 2 and length 1 but got %v`, err)
+	}
+}
+
+func Test_Get_and_RemoveAt_errors(t *testing.T) {
+	list := List[int]{}
+
+	if _, err := list.Get(0); !errors.Is(err, common_errors.ErrEmpty) {
+		t.Fatalf(`Expected ErrEmpty but got %v`, err)
+	}
+	if _, err := list.RemoveAt(0); !errors.Is(err, common_errors.ErrEmpty) {
+		t.Fatalf(`Expected ErrEmpty but got %v`, err)
+	}
+
+	list.Append(1)
+
+	_, err := list.Get(1)
+	var index_err *common_errors.Index_Error
+	if !errors.As(err, &index_err) || index_err.Index != 1 || index_err.Length != 1 {
+		t.Fatalf(`Expected *Index_Error for index 1 and length 1 but got %v`, err)
+	}
+	if _, err := list.RemoveAt(1); !errors.Is(err, common_errors.ErrIndexOutOfRange) {
+		t.Fatalf(`Expected ErrIndexOutOfRange but got %v`, err)
+	}
+}